@@ -0,0 +1,42 @@
+package holidaysapi
+
+import (
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/shogo82148/holidays-jp/holidays-api/holiday"
+)
+
+// calendarFromQuery returns the calendar specified by the as_of query parameter.
+// as_of is a date in JST, such as "2020-12-03", or a time in RFC 3339, such as "2020-12-03T12:00:00+09:00".
+// The holidays are from the table and the laws known at the time. See holiday.AsOf for the limitations.
+// If it is omitted, it returns the current calendar.
+func calendarFromQuery(u *url.URL) (*holiday.Calendar, error) {
+	q := u.Query()
+	if !q.Has("as_of") {
+		return holiday.National(), nil
+	}
+
+	s := q.Get("as_of")
+	if t, err := time.ParseInLocation("2006-01-02", s, jst); err == nil {
+		return holiday.AsOf(t), nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return nil, err
+	}
+	return holiday.AsOf(t), nil
+}
+
+// rejectAsOf responds 400 Bad Request if the as_of query parameter is specified,
+// and reports whether it responded.
+// It is for the apis that don't support as_of,
+// so that they don't silently return the answers based on the current laws.
+func (h *Handler) rejectAsOf(w http.ResponseWriter, u *url.URL) bool {
+	if !u.Query().Has("as_of") {
+		return false
+	}
+	h.responseBadRequest(w, "as_of is not supported by this api")
+	return true
+}
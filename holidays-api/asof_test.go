@@ -0,0 +1,156 @@
+package holidaysapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestServeHTTP_AsOf(t *testing.T) {
+	h := NewHandler()
+
+	tests := []struct {
+		path string
+		want Response
+	}{
+		{
+			// the law that moved the holidays for Tokyo Olympics was published on 2020-12-04.
+			path: "/holidays?from=2021-07-01&to=2021-10-31&as_of=2020-12-03",
+			want: Response{
+				Holidays: []Holiday{
+					{Date: "2021-07-19", Name: "海の日", NameKana: "うみのひ", Kind: "national", ID: "marine-day", Era: "令和", EraYear: 3},
+					{Date: "2021-08-11", Name: "山の日", NameKana: "やまのひ", Kind: "national", ID: "mountain-day", Era: "令和", EraYear: 3},
+					{Date: "2021-09-20", Name: "敬老の日", NameKana: "けいろうのひ", Kind: "national", ID: "respect-for-the-aged-day", Era: "令和", EraYear: 3},
+					{Date: "2021-09-23", Name: "秋分の日", NameKana: "しゅうぶんのひ", Kind: "national", ID: "autumnal-equinox-day", Era: "令和", EraYear: 3},
+					{Date: "2021-10-11", Name: "スポーツの日", NameKana: "すぽーつのひ", Kind: "national", ID: "sports-day", Era: "令和", EraYear: 3},
+				},
+			},
+		},
+		{
+			path: "/2021/10/11?as_of=2020-12-03T23:59:59%2B09:00",
+			want: Response{
+				Holidays: []Holiday{
+					{Date: "2021-10-11", Name: "スポーツの日", NameKana: "すぽーつのひ", Kind: "national", ID: "sports-day", Era: "令和", EraYear: 3},
+				},
+			},
+		},
+		{
+			path: "/2021/10/11?as_of=2020-12-03T15:00:00Z",
+			want: Response{
+				Holidays: []Holiday{},
+			},
+		},
+		{
+			path: "/2021/07?as_of=2020-12-04",
+			want: Response{
+				Holidays: []Holiday{
+					{Date: "2021-07-22", Name: "海の日", NameKana: "うみのひ", Kind: "national", ID: "marine-day", Era: "令和", EraYear: 3},
+					{Date: "2021-07-23", Name: "スポーツの日", NameKana: "すぽーつのひ", Kind: "national", ID: "sports-day", Era: "令和", EraYear: 3},
+				},
+			},
+		},
+		{
+			path: "/next?date=2021-10-01&as_of=2020-12-03",
+			want: Response{
+				Holidays: []Holiday{
+					{Date: "2021-10-11", Name: "スポーツの日", NameKana: "すぽーつのひ", Kind: "national", ID: "sports-day", Era: "令和", EraYear: 3},
+				},
+			},
+		},
+		{
+			path: "/by-id/sports-day?from=2021-01-01&to=2021-12-31&as_of=2020-12-03",
+			want: Response{
+				Holidays: []Holiday{
+					{Date: "2021-10-11", Name: "スポーツの日", NameKana: "すぽーつのひ", Kind: "national", ID: "sports-day", Era: "令和", EraYear: 3},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodGet, "http://example.com"+tt.path, nil)
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+
+		resp := w.Result()
+		if resp.StatusCode != http.StatusOK {
+			t.Errorf("%s: unexpected status code: want %d, got %d", tt.path, http.StatusOK, resp.StatusCode)
+			continue
+		}
+
+		var got Response
+		if err := json.NewDecoder(resp.Body).Decode(&got); err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(tt.want, got); diff != "" {
+			t.Errorf("%s: unexpected response: (-want/+got)\n%s", tt.path, diff)
+		}
+	}
+
+	for _, path := range []string{"/2021?as_of=", "/2021?as_of=2020-12", "/2021?as_of=2020-12-03T12:00:00"} {
+		req := httptest.NewRequest(http.MethodGet, "http://example.com"+path, nil)
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+		if w.Code != http.StatusNotFound {
+			t.Errorf("%s: want %d, got %d", path, http.StatusNotFound, w.Code)
+		}
+	}
+}
+
+func TestServeHTTP_AsOfLongWeekends(t *testing.T) {
+	h := NewHandler()
+
+	// the four-day weekend from 2021-07-22 was made by the law published on 2020-12-04.
+	tests := []struct {
+		path string
+		want bool
+	}{
+		{"/2021/long-weekends", true},
+		{"/2021/long-weekends?as_of=2020-12-04", true},
+		{"/2021/long-weekends?as_of=2020-12-03", false},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodGet, "http://example.com"+tt.path, nil)
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+		if w.Code != http.StatusOK {
+			t.Errorf("%s: unexpected status code: want %d, got %d", tt.path, http.StatusOK, w.Code)
+			continue
+		}
+
+		var got LongWeekendsResponse
+		if err := json.NewDecoder(w.Body).Decode(&got); err != nil {
+			t.Fatal(err)
+		}
+		var found bool
+		for _, lw := range got.LongWeekends {
+			if lw.Start == "2021-07-22" {
+				found = true
+			}
+		}
+		if found != tt.want {
+			t.Errorf("%s: want the long weekend from 2021-07-22 %t, got %t", tt.path, tt.want, found)
+		}
+	}
+}
+
+func TestServeHTTP_AsOfNotSupported(t *testing.T) {
+	h := NewHandler()
+
+	paths := []string{
+		"/paydays?rule=25%E6%97%A5&year=2021&as_of=2020-12-03",
+		"/explain/2021/10/11?as_of=2020-12-03",
+		"/rokuyo/2021/10?as_of=2020-12-03",
+		"/markets/jpx/2021?as_of=2020-12-03",
+	}
+	for _, path := range paths {
+		req := httptest.NewRequest(http.MethodGet, "http://example.com"+path, nil)
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("%s: want %d, got %d", path, http.StatusBadRequest, w.Code)
+		}
+	}
+}
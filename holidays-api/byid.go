@@ -0,0 +1,44 @@
+package holidaysapi
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/shogo82148/holidays-jp/holidays-api/holiday"
+)
+
+// nationalHolidayLawYear is the year that the National Holiday Law (国民の祝日に関する法律) was enacted.
+// It is the default start of the by-id api.
+const nationalHolidayLawYear = 1948
+
+func (h *Handler) holidaysByID(w http.ResponseWriter, cal *holiday.Calendar, id string, u *url.URL, lang holiday.Language) error {
+	if !holiday.IsHolidayID(id) {
+		return errors.New("unknown holiday")
+	}
+
+	// the default range is from the enactment of the law to the end of the published data.
+	from := holiday.Date{Year: nationalHolidayLawYear, Month: time.January, Day: 1}
+	to := holiday.Date{Year: holiday.CurrentDataset().EndYear(), Month: time.December, Day: 31}
+	q := u.Query()
+	if q.Has("from") {
+		d, err := holiday.ParseDate(q.Get("from"))
+		if err != nil {
+			return err
+		}
+		from = d
+	}
+	if q.Has("to") {
+		d, err := holiday.ParseDate(q.Get("to"))
+		if err != nil {
+			return err
+		}
+		to = d
+	}
+
+	w.Header().Set("Cache-Control", fmt.Sprintf("max-age=%d", 24*60*60))
+	h.responseHolidays(w, cal.FindHolidaysByID(id, from, to), lang)
+	return nil
+}
@@ -0,0 +1,64 @@
+package holidaysapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestServeHTTP_ByID(t *testing.T) {
+	h := NewHandler()
+
+	req := httptest.NewRequest(http.MethodGet, "http://example.com/by-id/sports-day?from=2019-01-01&to=2020-12-31", nil)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+
+	resp := w.Result()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("unexpected status code: want %d, got %d", http.StatusOK, resp.StatusCode)
+	}
+	if resp.Header.Get("Cache-Control") == "" {
+		t.Error("Cache-Control is not set")
+	}
+
+	var got Response
+	if err := json.NewDecoder(resp.Body).Decode(&got); err != nil {
+		t.Fatal(err)
+	}
+	want := Response{
+		Holidays: []Holiday{
+			{Date: "2019-10-14", Name: "体育の日（スポーツの日）", NameKana: "たいいくのひ（すぽーつのひ）", Kind: "national", ID: "sports-day", Era: "令和", EraYear: 1},
+			{Date: "2020-07-24", Name: "スポーツの日", NameKana: "すぽーつのひ", Kind: "national", ID: "sports-day", Era: "令和", EraYear: 2},
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("unexpected response: (-want/+got)\n%s", diff)
+	}
+
+	// the default range starts from the enactment of the law in July 1948.
+	req = httptest.NewRequest(http.MethodGet, "http://example.com/by-id/emperors-birthday", nil)
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	if w.Code != http.StatusOK {
+		t.Fatalf("unexpected status code: want %d, got %d", http.StatusOK, w.Code)
+	}
+	got = Response{}
+	if err := json.NewDecoder(w.Body).Decode(&got); err != nil {
+		t.Fatal(err)
+	}
+	if len(got.Holidays) == 0 || got.Holidays[0].Date != "1949-04-29" {
+		t.Errorf("unexpected first holiday: %v", got.Holidays)
+	}
+
+	for _, path := range []string{"/by-id/", "/by-id/unknown", "/by-id/スポーツの日", "/by-id/sports-day?from=2019"} {
+		req := httptest.NewRequest(http.MethodGet, "http://example.com"+path, nil)
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+		if w.Code != http.StatusNotFound {
+			t.Errorf("%s: want %d, got %d", path, http.StatusNotFound, w.Code)
+		}
+	}
+}
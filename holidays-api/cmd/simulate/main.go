@@ -0,0 +1,75 @@
+// simulate calculates the holidays under a hypothetical amendment of the law,
+// and compares them with the current holidays.
+//
+//	go run ./cmd/simulate -overlay path/to/bill.yaml [-from 2006-01-02] [-to 2006-01-02] [-holidays]
+//
+// The range defaults to the first year that the amendment changes.
+// See holiday.ParseRuleOverlay for the format of the overlay.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/shogo82148/holidays-jp/holidays-api/holiday"
+)
+
+func main() {
+	if err := _main(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func _main() error {
+	var overlayPath, fromStr, toStr string
+	var listHolidays bool
+	flag.StringVar(&overlayPath, "overlay", "", "path to the rule overlay in the YAML format.")
+	flag.StringVar(&fromStr, "from", "", "the first day of the simulation. January 1 of the first amended year is used if it is empty.")
+	flag.StringVar(&toStr, "to", "", "the last day of the simulation. December 31 of the year of -from is used if it is empty.")
+	flag.BoolVar(&listHolidays, "holidays", false, "list all the holidays under the amended law.")
+	flag.Parse()
+
+	if overlayPath == "" {
+		return errors.New("-overlay is required")
+	}
+	o, err := holiday.LoadRuleOverlayFile(overlayPath)
+	if err != nil {
+		return err
+	}
+
+	from := holiday.Date{Year: o.BeginYear(), Month: time.January, Day: 1}
+	if fromStr != "" {
+		from, err = holiday.ParseDate(fromStr)
+		if err != nil {
+			return fmt.Errorf("invalid -from: %w", err)
+		}
+	}
+	to := holiday.Date{Year: from.Year, Month: time.December, Day: 31}
+	if toStr != "" {
+		to, err = holiday.ParseDate(toStr)
+		if err != nil {
+			return fmt.Errorf("invalid -to: %w", err)
+		}
+	}
+
+	s, err := holiday.Simulate(o, from, to)
+	if err != nil {
+		return err
+	}
+	if listHolidays {
+		for _, h := range s.Holidays {
+			fmt.Fprintf(os.Stdout, "%s %s (%s)\n", h.Date, h.Name, h.Kind)
+		}
+		fmt.Fprintln(os.Stdout)
+	}
+	for _, d := range s.Diffs {
+		fmt.Fprintln(os.Stdout, d)
+	}
+	fmt.Fprintf(os.Stdout, "%d changes in %s - %s\n", len(s.Diffs), s.From, s.To)
+	fmt.Fprintf(os.Stdout, "business days: current %d, simulated %d (%+d)\n", s.BusinessDays, s.SimulatedBusinessDays, s.SimulatedBusinessDays-s.BusinessDays)
+	return nil
+}
@@ -0,0 +1,56 @@
+// verify compares the holidays calculated based on the law with syukujitsu.csv.
+//
+//	go run ./cmd/verify [-csv path/to/syukujitsu.csv] [-rules path/to/rules.yaml]
+//
+// It exits with a non-zero status if there are mismatches.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/shogo82148/holidays-jp/holidays-api/holiday"
+)
+
+func main() {
+	if err := _main(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func _main() error {
+	var csvPath string
+	flag.StringVar(&csvPath, "csv", "", "path to syukujitsu.csv. the bundled table is used if it is empty.")
+	var rulesPath string
+	flag.StringVar(&rulesPath, "rules", "", "path to the rules of the national holidays. the bundled rules are used if it is empty.")
+	flag.Parse()
+
+	if rulesPath != "" {
+		rs, err := holiday.LoadRuleSetFile(rulesPath)
+		if err != nil {
+			return err
+		}
+		holiday.SetRuleSet(rs)
+	}
+
+	ds := holiday.DefaultDataset()
+	if csvPath != "" {
+		var err error
+		ds, err = holiday.LoadCSVFile(csvPath)
+		if err != nil {
+			return err
+		}
+	}
+
+	mismatches := holiday.Verify(ds)
+	for _, m := range mismatches {
+		fmt.Fprintln(os.Stdout, m)
+	}
+	if len(mismatches) > 0 {
+		return fmt.Errorf("%d mismatches between the calculation and the table in %d-%d", len(mismatches), ds.StartYear(), ds.EndYear())
+	}
+	fmt.Fprintf(os.Stdout, "ok: the calculation matches the table in %d-%d\n", ds.StartYear(), ds.EndYear())
+	return nil
+}
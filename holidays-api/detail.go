@@ -0,0 +1,54 @@
+package holidaysapi
+
+import (
+	"net/http"
+
+	"github.com/shogo82148/holidays-jp/holidays-api/holiday"
+)
+
+// HolidayDetail is the legal basis of a national holiday.
+type HolidayDetail struct {
+	// Purpose is the purpose (趣旨) of the holiday quoted from the law.
+	// It is always in Japanese.
+	Purpose string `json:"purpose"`
+
+	// Laws are the laws that set the holiday in the year:
+	// the National Holiday Law and the amendments that added, moved or renamed the holiday.
+	Laws []Law `json:"laws"`
+
+	// EstablishedYear is the year that the law establishing the holiday was enacted.
+	EstablishedYear int `json:"established_year"`
+}
+
+func (h *Handler) responseHolidaysWithDetail(w http.ResponseWriter, cal *holiday.Calendar, holidays []holiday.Holiday, lang holiday.Language) {
+	w.Header().Set("Content-Language", string(lang))
+	w.Header().Set("Vary", "Accept-Language")
+
+	res := make([]Holiday, 0, len(holidays))
+	for _, d := range holidays {
+		ret := newHoliday(d, lang)
+		if detail, ok := cal.Detail(d); ok {
+			ret.Detail = &HolidayDetail{
+				Purpose:         detail.Purpose,
+				Laws:            newLaws(detail.Laws),
+				EstablishedYear: detail.EstablishedYear,
+			}
+		}
+		res = append(res, ret)
+	}
+	h.responseJSON(w, Response{
+		Holidays: res,
+	})
+}
+
+func newLaws(laws []holiday.Law) []Law {
+	ret := make([]Law, 0, len(laws))
+	for _, law := range laws {
+		ret = append(ret, Law{
+			Number: law.Number,
+			Title:  law.Title,
+			URL:    law.URL,
+		})
+	}
+	return ret
+}
@@ -0,0 +1,106 @@
+package holidaysapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestServeHTTP_Detail(t *testing.T) {
+	h := NewHandler()
+
+	req := httptest.NewRequest(http.MethodGet, "http://example.com/2025?detail=true", nil)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+
+	resp := w.Result()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("unexpected status code: want %d, got %d", http.StatusOK, resp.StatusCode)
+	}
+
+	var res Response
+	if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+		t.Fatal(err)
+	}
+	for _, d := range res.Holidays {
+		switch {
+		case d.Date == "2025-08-11":
+			want := &HolidayDetail{
+				Purpose: "山に親しむ機会を得て、山の恩恵に感謝する。",
+				Laws: []Law{
+					{
+						Number: "昭和二十三年法律第百七十八号",
+						Title:  "国民の祝日に関する法律",
+						URL:    "https://elaws.e-gov.go.jp/document?lawid=323AC1000000178",
+					},
+					{
+						Number: "平成二十六年法律第四十三号",
+						Title:  "国民の祝日に関する法律の一部を改正する法律",
+						URL:    "https://www.shugiin.go.jp/internet/itdb_housei.nsf/html/housei/18620140530043.htm",
+					},
+				},
+				EstablishedYear: 2014,
+			}
+			if diff := cmp.Diff(want, d.Detail); diff != "" {
+				t.Errorf("unexpected detail: (-want/+got)\n%s", diff)
+			}
+		case d.Kind != "national":
+			// substitute holidays have no detail
+			if d.Detail != nil {
+				t.Errorf("want no detail, got %v", d.Detail)
+			}
+		default:
+			if d.Detail == nil || d.Detail.Purpose == "" {
+				t.Errorf("%s: want the detail, got %v", d.Date, d.Detail)
+			}
+		}
+	}
+
+	// the detail is omitted by default
+	req = httptest.NewRequest(http.MethodGet, "http://example.com/2025", nil)
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	res = Response{}
+	if err := json.NewDecoder(w.Body).Decode(&res); err != nil {
+		t.Fatal(err)
+	}
+	for _, d := range res.Holidays {
+		if d.Detail != nil {
+			t.Errorf("%s: want no detail, got %v", d.Date, d.Detail)
+		}
+	}
+}
+
+func TestServeHTTP_DetailAsOf(t *testing.T) {
+	h := NewHandler()
+
+	// the law published on 2020-12-04 is not cited as of 2020-12-03.
+	req := httptest.NewRequest(http.MethodGet, "http://example.com/2021?detail=true&as_of=2020-12-03", nil)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	if w.Code != http.StatusOK {
+		t.Fatalf("unexpected status code: want %d, got %d", http.StatusOK, w.Code)
+	}
+
+	var res Response
+	if err := json.NewDecoder(w.Body).Decode(&res); err != nil {
+		t.Fatal(err)
+	}
+	for _, d := range res.Holidays {
+		if d.Kind == "national" && d.Detail == nil {
+			t.Errorf("%s: want the detail, got nil", d.Date)
+			continue
+		}
+		if d.Detail == nil {
+			continue
+		}
+		for _, law := range d.Detail.Laws {
+			if law.Number == "令和二年法律第六十八号" {
+				t.Errorf("%s: want no citation of the law published on 2020-12-04, got %v", d.Date, d.Detail.Laws)
+			}
+		}
+	}
+}
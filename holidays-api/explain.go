@@ -0,0 +1,86 @@
+package holidaysapi
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/shogo82148/holidays-jp/holidays-api/holiday"
+)
+
+// ExplainResponse is the response of the explain api.
+type ExplainResponse struct {
+	Date string `json:"date"`
+
+	// IsHoliday reports whether the date is a holiday.
+	IsHoliday bool `json:"is_holiday"`
+
+	// Holiday is the holiday on the date. It is null if the date is not a holiday.
+	Holiday *Holiday `json:"holiday"`
+
+	// Source is "table" for the table published by the Cabinet Office,
+	// or "calculation" for the calculation based on the law.
+	Source string `json:"source"`
+
+	// BeginYear is the year that the rule enforced on the date begins.
+	// It is zero if no rule is enforced.
+	BeginYear int `json:"begin_year"`
+
+	Steps []ExplainStep `json:"steps"`
+}
+
+// ExplainStep is a clause examined to determine whether the date is a holiday.
+type ExplainStep struct {
+	Clause      string `json:"clause"`
+	Description string `json:"description"`
+	Matched     bool   `json:"matched"`
+	Laws        []Law  `json:"laws"`
+}
+
+// Law is a citation of a law.
+type Law struct {
+	Number string `json:"number"`
+	Title  string `json:"title"`
+	URL    string `json:"url"`
+}
+
+func (h *Handler) explain(w http.ResponseWriter, path string, lang holiday.Language) error {
+	year, month, day, err := parsePath(path)
+	if err != nil {
+		return err
+	}
+	if year == 0 || month == 0 || day == 0 {
+		return errors.New("invalid date")
+	}
+	if _, err := time.Parse("2006/01/02", fmt.Sprintf("%04d/%02d/%02d", year, month, day)); err != nil {
+		return err
+	}
+	h.setCacheControlForYear(w, year)
+
+	e := holiday.Explain(holiday.Date{Year: year, Month: time.Month(month), Day: day})
+	res := ExplainResponse{
+		Date:      e.Date.String(),
+		IsHoliday: e.IsHoliday,
+		Source:    e.Source,
+		BeginYear: e.BeginYear,
+		Steps:     make([]ExplainStep, 0, len(e.Steps)),
+	}
+	if e.IsHoliday {
+		d := newHoliday(e.Holiday, lang)
+		res.Holiday = &d
+	}
+	for _, step := range e.Steps {
+		res.Steps = append(res.Steps, ExplainStep{
+			Clause:      step.Clause,
+			Description: step.Description,
+			Matched:     step.Matched,
+			Laws:        newLaws(step.Laws),
+		})
+	}
+
+	w.Header().Set("Content-Language", string(lang))
+	w.Header().Set("Vary", "Accept-Language")
+	h.responseJSON(w, res)
+	return nil
+}
@@ -0,0 +1,73 @@
+package holidaysapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestServeHTTP_Explain(t *testing.T) {
+	h := NewHandler()
+
+	t.Run("substitute", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "http://example.com/explain/2025/05/06", nil)
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+
+		resp := w.Result()
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("unexpected status code: want %d, got %d", http.StatusOK, resp.StatusCode)
+		}
+		if resp.Header.Get("Cache-Control") == "" {
+			t.Error("Cache-Control is not set")
+		}
+
+		var res ExplainResponse
+		if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+			t.Fatal(err)
+		}
+		if !res.IsHoliday || res.Holiday == nil || res.Holiday.Kind != "substitute" {
+			t.Errorf("want a substitute holiday, got %v", res.Holiday)
+		}
+		if res.Source != "table" || res.BeginYear != 2022 {
+			t.Errorf("want table, 2022, got %q, %d", res.Source, res.BeginYear)
+		}
+		var found bool
+		for _, step := range res.Steps {
+			if step.Clause == "substitute" && step.Matched && len(step.Laws) > 0 && step.Laws[0].Number == "平成十七年法律第四十三号" {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("the substitute clause is not found: %v", res.Steps)
+		}
+	})
+
+	t.Run("not holiday", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "http://example.com/explain/2025/05/07", nil)
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+
+		resp := w.Result()
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("unexpected status code: want %d, got %d", http.StatusOK, resp.StatusCode)
+		}
+		var res ExplainResponse
+		if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+			t.Fatal(err)
+		}
+		if res.IsHoliday || res.Holiday != nil {
+			t.Errorf("want not a holiday, got %v", res.Holiday)
+		}
+	})
+
+	for _, path := range []string{"/explain/2025", "/explain/2025/05", "/explain/2025/02/30", "/explain/2025/5/6"} {
+		req := httptest.NewRequest(http.MethodGet, "http://example.com"+path, nil)
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+		if w.Code != http.StatusNotFound {
+			t.Errorf("%s: want %d, got %d", path, http.StatusNotFound, w.Code)
+		}
+	}
+}
@@ -5,4 +5,6 @@ go 1.27.0
 require (
 	github.com/google/go-cmp v0.7.0
 	github.com/shogo82148/ridgenative v1.5.1
+	golang.org/x/text v0.41.0
+	gopkg.in/yaml.v3 v3.0.1
 )
@@ -0,0 +1,20 @@
+package holiday
+
+// bankOverlay is the bank holidays other than the national holidays, Saturdays and Sundays.
+var bankOverlay = &Overlay{
+	Holidays: []OverlayDate{
+		{Date: "12-31", Name: "銀行休業日"},
+		{Date: "01-02", Name: "銀行休業日"},
+		{Date: "01-03", Name: "銀行休業日"},
+	},
+}
+
+var bank = mustNewCalendar(bankOverlay)
+
+// Bank returns the calendar of the bank holidays (銀行休業日) defined by Article 5 of
+// the Order for Enforcement of the Banking Act (銀行法施行令):
+// Saturdays, Sundays, the national holidays and December 31 to January 3.
+// The business days in the calendar are the bank business days (銀行営業日).
+func Bank() *Calendar {
+	return bank
+}
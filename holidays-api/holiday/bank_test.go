@@ -0,0 +1,33 @@
+package holiday
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBank_IsBusinessDay(t *testing.T) {
+	bank := Bank()
+	tests := []struct {
+		date Date
+		want bool
+	}{
+		{Date{2024, time.December, 30}, true},
+		{Date{2024, time.December, 31}, false},
+		{Date{2025, time.January, 1}, false},
+		{Date{2025, time.January, 2}, false},
+		{Date{2025, time.January, 3}, false},
+		{Date{2025, time.January, 6}, true},
+		{Date{2025, time.May, 6}, false},
+		{Date{2025, time.May, 10}, false},
+	}
+	for _, tt := range tests {
+		if got := bank.IsBusinessDay(tt.date); got != tt.want {
+			t.Errorf("%s: want %t, got %t", tt.date, tt.want, got)
+		}
+	}
+
+	// the national calendar doesn't close at the new year.
+	if !National().IsBusinessDay(Date{2025, time.January, 2}) {
+		t.Error("2025-01-02 is a business day in the national calendar")
+	}
+}
@@ -0,0 +1,124 @@
+package holiday
+
+import "time"
+
+// IsBusinessDay reports whether d is a business day.
+// Saturdays, Sundays and holidays are not business days.
+func IsBusinessDay(d Date) bool {
+	return national.IsBusinessDay(d)
+}
+
+// NextBusinessDay returns the first business day after d.
+func NextBusinessDay(d Date) Date {
+	return national.NextBusinessDay(d)
+}
+
+// PreviousBusinessDay returns the last business day before d.
+func PreviousBusinessDay(d Date) Date {
+	return national.PreviousBusinessDay(d)
+}
+
+// AddBusinessDays returns the date n business days after d.
+// If n is negative, it returns the date -n business days before d.
+// If n is zero, it returns d even if d is not a business day.
+func AddBusinessDays(d Date, n int) Date {
+	return national.AddBusinessDays(d, n)
+}
+
+// BusinessDaysBetween returns the number of business days
+// after from and on or before to.
+// If to is before from, it returns the negated number of business days
+// after to and on or before from.
+// It satisfies AddBusinessDays(from, BusinessDaysBetween(from, to)) == to if to is a business day.
+func BusinessDaysBetween(from, to Date) int {
+	return national.BusinessDaysBetween(from, to)
+}
+
+// IsBusinessDay reports whether d is a business day in the calendar.
+// Saturdays, Sundays and holidays are not business days,
+// unless the overlays declare them as working days.
+func (c *Calendar) IsBusinessDay(d Date) bool {
+	checker := businessDayChecker{calendar: c}
+	return checker.isBusinessDay(d)
+}
+
+// NextBusinessDay returns the first business day after d in the calendar.
+func (c *Calendar) NextBusinessDay(d Date) Date {
+	return c.AddBusinessDays(d, 1)
+}
+
+// PreviousBusinessDay returns the last business day before d in the calendar.
+func (c *Calendar) PreviousBusinessDay(d Date) Date {
+	return c.AddBusinessDays(d, -1)
+}
+
+// AddBusinessDays returns the date n business days after d in the calendar.
+// If n is negative, it returns the date -n business days before d.
+// If n is zero, it returns d even if d is not a business day.
+func (c *Calendar) AddBusinessDays(d Date, n int) Date {
+	step := 1
+	if n < 0 {
+		step, n = -1, -n
+	}
+
+	checker := businessDayChecker{calendar: c}
+	for n > 0 {
+		d = d.AddDays(step)
+		if checker.isBusinessDay(d) {
+			n--
+		}
+	}
+	return d
+}
+
+// BusinessDaysBetween returns the number of business days in the calendar
+// after from and on or before to.
+// If to is before from, it returns the negated number of business days
+// after to and on or before from.
+func (c *Calendar) BusinessDaysBetween(from, to Date) int {
+	sign := 1
+	if from.Compare(to) > 0 {
+		from, to = to, from
+		sign = -1
+	}
+
+	checker := businessDayChecker{calendar: c}
+	var n int
+	for d := from.AddDays(1); d.Compare(to) <= 0; d = d.AddDays(1) {
+		if checker.isBusinessDay(d) {
+			n++
+		}
+	}
+	return sign * n
+}
+
+// businessDayChecker checks business days.
+// It caches the holidays of the last month looked up,
+// because the calculation of holidays is heavy for the years that are not pre-calculated.
+type businessDayChecker struct {
+	calendar *Calendar
+	year     int
+	month    time.Month
+	holidays map[int]bool
+}
+
+func (c *businessDayChecker) isBusinessDay(d Date) bool {
+	if c.calendar.isWorkingDay(d) {
+		return true
+	}
+
+	switch d.Weekday() {
+	case time.Saturday, time.Sunday:
+		return false
+	}
+
+	if c.holidays == nil || c.year != d.Year || c.month != d.Month {
+		c.year, c.month = d.Year, d.Month
+		c.holidays = make(map[int]bool)
+		for _, h := range c.calendar.FindHolidaysInMonth(d.Year, d.Month) {
+			day := mustParseDate(h.Date).Day()
+			c.holidays[day] = true
+		}
+	}
+	return !c.holidays[d.Day]
+}
@@ -0,0 +1,102 @@
+package holiday
+
+import (
+	"testing"
+	"time"
+)
+
+func TestIsBusinessDay(t *testing.T) {
+	tests := []struct {
+		date Date
+		want bool
+	}{
+		{Date{2025, time.May, 2}, true},      // Friday
+		{Date{2025, time.May, 3}, false},     // Saturday, 憲法記念日
+		{Date{2025, time.May, 4}, false},     // Sunday, みどりの日
+		{Date{2025, time.May, 5}, false},     // Monday, こどもの日
+		{Date{2025, time.May, 6}, false},     // Tuesday, 休日
+		{Date{2025, time.May, 7}, true},      // Wednesday
+		{Date{2030, time.January, 1}, false}, // Tuesday, 元日 (calculated)
+		{Date{2030, time.January, 2}, true},  // Wednesday (calculated)
+	}
+	for _, tt := range tests {
+		if got := IsBusinessDay(tt.date); got != tt.want {
+			t.Errorf("IsBusinessDay(%s): want %t, got %t", tt.date, tt.want, got)
+		}
+	}
+}
+
+func TestNextBusinessDay(t *testing.T) {
+	tests := []struct {
+		date Date
+		want Date
+	}{
+		{Date{2025, time.May, 2}, Date{2025, time.May, 7}},
+		{Date{2025, time.May, 7}, Date{2025, time.May, 8}},
+		{Date{2027, time.December, 31}, Date{2028, time.January, 3}},
+		{Date{2029, time.December, 31}, Date{2030, time.January, 2}},
+	}
+	for _, tt := range tests {
+		if got := NextBusinessDay(tt.date); got != tt.want {
+			t.Errorf("NextBusinessDay(%s): want %s, got %s", tt.date, tt.want, got)
+		}
+	}
+}
+
+func TestPreviousBusinessDay(t *testing.T) {
+	tests := []struct {
+		date Date
+		want Date
+	}{
+		{Date{2025, time.May, 7}, Date{2025, time.May, 2}},
+		{Date{2025, time.May, 8}, Date{2025, time.May, 7}},
+		{Date{2030, time.January, 2}, Date{2029, time.December, 31}},
+	}
+	for _, tt := range tests {
+		if got := PreviousBusinessDay(tt.date); got != tt.want {
+			t.Errorf("PreviousBusinessDay(%s): want %s, got %s", tt.date, tt.want, got)
+		}
+	}
+}
+
+func TestAddBusinessDays(t *testing.T) {
+	tests := []struct {
+		date Date
+		n    int
+		want Date
+	}{
+		{Date{2025, time.May, 1}, 0, Date{2025, time.May, 1}},
+		{Date{2025, time.May, 3}, 0, Date{2025, time.May, 3}},
+		{Date{2025, time.May, 1}, 2, Date{2025, time.May, 7}},
+		{Date{2025, time.May, 7}, -2, Date{2025, time.May, 1}},
+		{Date{2025, time.April, 30}, 20, Date{2025, time.May, 30}},
+	}
+	for _, tt := range tests {
+		if got := AddBusinessDays(tt.date, tt.n); got != tt.want {
+			t.Errorf("AddBusinessDays(%s, %d): want %s, got %s", tt.date, tt.n, tt.want, got)
+		}
+	}
+}
+
+func TestBusinessDaysBetween(t *testing.T) {
+	tests := []struct {
+		from, to Date
+		want     int
+	}{
+		{Date{2025, time.May, 1}, Date{2025, time.May, 1}, 0},
+		{Date{2025, time.May, 1}, Date{2025, time.May, 7}, 2},
+		{Date{2025, time.May, 7}, Date{2025, time.May, 1}, -2},
+		{Date{2025, time.April, 30}, Date{2025, time.May, 31}, 20},
+	}
+	for _, tt := range tests {
+		got := BusinessDaysBetween(tt.from, tt.to)
+		if got != tt.want {
+			t.Errorf("BusinessDaysBetween(%s, %s): want %d, got %d", tt.from, tt.to, tt.want, got)
+		}
+		if got > 0 && IsBusinessDay(tt.to) {
+			if d := AddBusinessDays(tt.from, got); d != tt.to {
+				t.Errorf("AddBusinessDays(%s, %d): want %s, got %s", tt.from, got, tt.to, d)
+			}
+		}
+	}
+}
@@ -0,0 +1,269 @@
+package holiday
+
+import (
+	"iter"
+	"slices"
+	"sort"
+	"time"
+)
+
+// the maximum year that a Calendar searches holidays.
+const calendarMaxYear = 9999
+
+// Calendar is a holiday calendar that layers overlays on the national holidays in Japan.
+type Calendar struct {
+	// holidays are the days added as holidays.
+	holidays []overlayDate
+
+	// workingDays are the days that are working days
+	// even if they are holidays or weekends.
+	workingDays []overlayDate
+
+	// rules are the rules of the national holidays that were known in the past. see AsOf.
+	// nil means the current dataset and rules.
+	rules *RuleSet
+
+	// table is the table that was known in the past, that is used with rules. see AsOf.
+	// nil means the holidays are calculated from rules.
+	table *Dataset
+}
+
+var national = &Calendar{}
+
+// National returns the calendar of the national holidays in Japan.
+func National() *Calendar {
+	return national
+}
+
+// NewCalendar returns a new calendar that layers the overlays on the national holidays.
+// If a date is both a holiday and a working day in the overlays, it is a working day.
+func NewCalendar(overlays ...*Overlay) (*Calendar, error) {
+	c := &Calendar{}
+	for _, o := range overlays {
+		for _, d := range o.Holidays {
+			date, err := parseOverlayDate(d)
+			if err != nil {
+				return nil, err
+			}
+			c.holidays = append(c.holidays, date)
+		}
+		for _, d := range o.WorkingDays {
+			date, err := parseOverlayDate(d)
+			if err != nil {
+				return nil, err
+			}
+			c.workingDays = append(c.workingDays, date)
+		}
+	}
+	return c, nil
+}
+
+func mustNewCalendar(overlays ...*Overlay) *Calendar {
+	c, err := NewCalendar(overlays...)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// isNational reports whether c has no overlays and uses the current rules.
+func (c *Calendar) isNational() bool {
+	return len(c.holidays) == 0 && len(c.workingDays) == 0 && c.rules == nil
+}
+
+// nationalHolidaysInMonth returns the national holidays in the month.
+func (c *Calendar) nationalHolidaysInMonth(year int, month time.Month) []Holiday {
+	if c.rules != nil {
+		if c.table != nil && c.table.contains(year) {
+			return c.table.findHolidaysInMonth(year, month)
+		}
+		return c.rules.holidaysInMonth(year, month)
+	}
+	return FindHolidaysInMonth(year, month)
+}
+
+// FindHoliday returns whether the specific day is a holiday.
+func (c *Calendar) FindHoliday(year int, month time.Month, day int) (Holiday, bool) {
+	if c.isNational() {
+		return FindHoliday(year, month, day)
+	}
+
+	date := Date{year, month, day}.String()
+	for _, h := range c.FindHolidaysInMonth(year, month) {
+		if h.Date == date {
+			return h, true
+		}
+	}
+	return Holiday{}, false
+}
+
+// FindHolidaysInMonth returns holidays in the month.
+func (c *Calendar) FindHolidaysInMonth(year int, month time.Month) []Holiday {
+	holidays := c.nationalHolidaysInMonth(year, month)
+	if c.isNational() {
+		return holidays
+	}
+
+	workingDays := make(map[string]bool)
+	for _, d := range c.workingDays {
+		if date, ok := d.in(year, month); ok {
+			workingDays[date.String()] = true
+		}
+	}
+
+	exists := make(map[string]bool)
+	result := make([]Holiday, 0, len(holidays))
+	for _, h := range holidays {
+		if workingDays[h.Date] {
+			continue
+		}
+		result = append(result, h)
+		exists[h.Date] = true
+	}
+	for _, d := range c.holidays {
+		date, ok := d.in(year, month)
+		if !ok {
+			continue
+		}
+		s := date.String()
+		if workingDays[s] || exists[s] {
+			continue
+		}
+		result = append(result, Holiday{
+			Date: s,
+			Name: d.Name,
+			Kind: KindCustom,
+		})
+		exists[s] = true
+	}
+	sort.Sort(withDate(result))
+	return result
+}
+
+// FindHolidaysInYear returns holidays in the year.
+func (c *Calendar) FindHolidaysInYear(year int) []Holiday {
+	if c.isNational() {
+		return FindHolidaysInYear(year)
+	}
+
+	var result []Holiday
+	for month := time.January; month <= time.December; month++ {
+		result = append(result, c.FindHolidaysInMonth(year, month)...)
+	}
+	return result
+}
+
+// FindHolidaysInRange returns holidays between from and to, inclusive.
+func (c *Calendar) FindHolidaysInRange(from, to Date) []Holiday {
+	return slices.Collect(c.All(from, to))
+}
+
+// FindHolidaysByID returns the holidays with the identifier between from and to, inclusive.
+// The holidays added by the overlays have no identifier, so they are never returned.
+func (c *Calendar) FindHolidaysByID(id string, from, to Date) []Holiday {
+	var result []Holiday
+	for _, h := range c.FindHolidaysInRange(from, to) {
+		if h.ID == id {
+			result = append(result, h)
+		}
+	}
+	return result
+}
+
+// NextHoliday returns the first holiday after d.
+// It returns false if there is no holiday after d.
+func (c *Calendar) NextHoliday(d Date) (Holiday, bool) {
+	for h := range c.From(d.AddDays(1)) {
+		return h, true
+	}
+	return Holiday{}, false
+}
+
+// PreviousHoliday returns the last holiday before d.
+// It returns false if there is no holiday before d.
+func (c *Calendar) PreviousHoliday(d Date) (Holiday, bool) {
+	if c.isNational() {
+		return PreviousHoliday(d)
+	}
+
+	date := d.String()
+	start := c.startYear()
+	for m := d.firstDay(); m.Year >= start; m = m.prevMonth() {
+		holidays := c.FindHolidaysInMonth(m.Year, m.Month)
+		for i := len(holidays) - 1; i >= 0; i-- {
+			if holidays[i].Date < date {
+				return holidays[i], true
+			}
+		}
+	}
+	return Holiday{}, false
+}
+
+// All returns an iterator over holidays between from and to, inclusive.
+// The holidays are yielded in chronological order.
+func (c *Calendar) All(from, to Date) iter.Seq[Holiday] {
+	if c.isNational() {
+		return All(from, to)
+	}
+
+	if from.Compare(to) > 0 {
+		from, to = to, from
+	}
+	startDate := from.String()
+	endDate := to.String()
+	if start := c.startYear(); from.Year < start {
+		from = Date{start, time.January, 1}
+	}
+
+	return func(yield func(Holiday) bool) {
+		for m := from.firstDay(); m.Compare(to) <= 0; m = m.nextMonth() {
+			for _, h := range c.FindHolidaysInMonth(m.Year, m.Month) {
+				if h.Date < startDate {
+					continue
+				}
+				if h.Date > endDate || !yield(h) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// From returns an iterator over holidays on or after d.
+// The holidays are yielded in chronological order.
+// The iterator runs until the end of year 9999, so the caller should stop the iteration.
+func (c *Calendar) From(d Date) iter.Seq[Holiday] {
+	if c.isNational() {
+		return From(d)
+	}
+	return c.All(d, Date{calendarMaxYear, time.December, 31})
+}
+
+// startYear returns the first year that c may have holidays.
+func (c *Calendar) startYear() int {
+	start := calcHolidaysStartYear()
+	if c.rules != nil {
+		start = c.rules.startYear()
+		if c.table != nil {
+			start = min(start, c.table.startYear)
+		}
+	}
+	for _, d := range c.holidays {
+		if d.Year == 0 {
+			// the date repeats every year
+			return 1
+		}
+		start = min(start, d.Year)
+	}
+	return start
+}
+
+// isWorkingDay reports whether d is a working day declared by the overlays.
+func (c *Calendar) isWorkingDay(d Date) bool {
+	for _, w := range c.workingDays {
+		if date, ok := w.in(d.Year, d.Month); ok && date == d {
+			return true
+		}
+	}
+	return false
+}
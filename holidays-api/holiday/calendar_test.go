@@ -0,0 +1,186 @@
+package holiday
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func newTestCalendar(t *testing.T) *Calendar {
+	t.Helper()
+	c, err := NewCalendar(&Overlay{
+		Holidays: []OverlayDate{
+			{Date: "12-29", Name: "年末年始休暇"},
+			{Date: "12-30", Name: "年末年始休暇"},
+			{Date: "12-31", Name: "年末年始休暇"},
+			{Date: "01-01", Name: "年末年始休暇"},
+			{Date: "01-02", Name: "年末年始休暇"},
+			{Date: "01-03", Name: "年末年始休暇"},
+			{Date: "2025-04-01", Name: "創立記念日"},
+		},
+		WorkingDays: []OverlayDate{
+			{Date: "2025-07-21", Name: "出勤日"}, // 海の日
+			{Date: "2025-06-14", Name: "出勤日"}, // Saturday
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func TestNewCalendar_Invalid(t *testing.T) {
+	_, err := NewCalendar(&Overlay{
+		Holidays: []OverlayDate{
+			{Date: "2025-02-29", Name: "invalid"},
+		},
+	})
+	if err == nil {
+		t.Error("want error, got nil")
+	}
+}
+
+func TestCalendar_FindHolidaysInMonth(t *testing.T) {
+	c := newTestCalendar(t)
+
+	t.Run("additions", func(t *testing.T) {
+		got := c.FindHolidaysInMonth(2025, time.January)
+		want := []Holiday{
+			{Date: "2025-01-01", Name: "元日", ID: "new-years-day", Kana: "がんじつ"},
+			{Date: "2025-01-02", Name: "年末年始休暇", Kind: KindCustom},
+			{Date: "2025-01-03", Name: "年末年始休暇", Kind: KindCustom},
+			{Date: "2025-01-13", Name: "成人の日", ID: "coming-of-age-day", Kana: "せいじんのひ"},
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("want %v, got %v", want, got)
+		}
+	})
+
+	t.Run("removals", func(t *testing.T) {
+		got := c.FindHolidaysInMonth(2025, time.July)
+		if len(got) != 0 {
+			t.Errorf("want no holidays, got %v", got)
+		}
+	})
+
+	t.Run("one-off", func(t *testing.T) {
+		got := c.FindHolidaysInMonth(2025, time.April)
+		want := []Holiday{
+			{Date: "2025-04-01", Name: "創立記念日", Kind: KindCustom},
+			{Date: "2025-04-29", Name: "昭和の日", ID: "showa-day", Kana: "しょうわのひ"},
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("want %v, got %v", want, got)
+		}
+
+		got = c.FindHolidaysInMonth(2026, time.April)
+		want = []Holiday{
+			{Date: "2026-04-29", Name: "昭和の日", ID: "showa-day", Kana: "しょうわのひ"},
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("want %v, got %v", want, got)
+		}
+	})
+
+	t.Run("national", func(t *testing.T) {
+		for month := time.January; month <= time.December; month++ {
+			got := National().FindHolidaysInMonth(2025, month)
+			want := FindHolidaysInMonth(2025, month)
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("%s: want %v, got %v", month, want, got)
+			}
+		}
+	})
+}
+
+func TestCalendar_FindHoliday(t *testing.T) {
+	c := newTestCalendar(t)
+
+	if h, ok := c.FindHoliday(2025, time.December, 30); !ok || h.Kind != KindCustom {
+		t.Errorf("2025-12-30: want a custom holiday, got %v, %t", h, ok)
+	}
+	if h, ok := c.FindHoliday(2025, time.July, 21); ok {
+		t.Errorf("2025-07-21: want a working day, got %v", h)
+	}
+}
+
+func TestCalendar_FindHolidaysInRange(t *testing.T) {
+	c := newTestCalendar(t)
+	got := c.FindHolidaysInRange(Date{2026, time.January, 2}, Date{2025, time.December, 30})
+	want := []Holiday{
+		{Date: "2025-12-30", Name: "年末年始休暇", Kind: KindCustom},
+		{Date: "2025-12-31", Name: "年末年始休暇", Kind: KindCustom},
+		{Date: "2026-01-01", Name: "元日", ID: "new-years-day", Kana: "がんじつ"},
+		{Date: "2026-01-02", Name: "年末年始休暇", Kind: KindCustom},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("want %v, got %v", want, got)
+	}
+}
+
+func TestCalendar_NextAndPreviousHoliday(t *testing.T) {
+	c := newTestCalendar(t)
+
+	h, ok := c.NextHoliday(Date{2025, time.July, 1})
+	if !ok || h.Date != "2025-08-11" {
+		t.Errorf("NextHoliday: want 2025-08-11, got %v, %t", h, ok)
+	}
+
+	h, ok = c.PreviousHoliday(Date{2025, time.August, 11})
+	if !ok || h.Date != "2025-05-06" {
+		t.Errorf("PreviousHoliday: want 2025-05-06, got %v, %t", h, ok)
+	}
+
+	// the yearly holidays repeat before the national holidays begin.
+	h, ok = c.PreviousHoliday(Date{1900, time.January, 1})
+	if !ok || h.Date != "1899-12-31" {
+		t.Errorf("PreviousHoliday: want 1899-12-31, got %v, %t", h, ok)
+	}
+}
+
+func TestCalendar_All(t *testing.T) {
+	c := newTestCalendar(t)
+
+	var got []string
+	for h := range c.All(Date{2025, time.December, 1}, Date{2026, time.January, 31}) {
+		got = append(got, h.Date)
+	}
+	want := []string{
+		"2025-12-29", "2025-12-30", "2025-12-31",
+		"2026-01-01", "2026-01-02", "2026-01-03", "2026-01-12",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("want %v, got %v", want, got)
+	}
+}
+
+func TestCalendar_BusinessDay(t *testing.T) {
+	c := newTestCalendar(t)
+
+	tests := []struct {
+		date Date
+		want bool
+	}{
+		{Date{2025, time.June, 13}, true},      // Friday
+		{Date{2025, time.June, 14}, true},      // Saturday, 出勤日
+		{Date{2025, time.June, 15}, false},     // Sunday
+		{Date{2025, time.July, 21}, true},      // Monday, 海の日, 出勤日
+		{Date{2025, time.April, 1}, false},     // Tuesday, 創立記念日
+		{Date{2025, time.December, 29}, false}, // Monday, 年末年始休暇
+	}
+	for _, tt := range tests {
+		if got := c.IsBusinessDay(tt.date); got != tt.want {
+			t.Errorf("IsBusinessDay(%s): want %t, got %t", tt.date, tt.want, got)
+		}
+	}
+
+	if got, want := c.NextBusinessDay(Date{2025, time.December, 26}), (Date{2026, time.January, 5}); got != want {
+		t.Errorf("NextBusinessDay: want %s, got %s", want, got)
+	}
+	if got, want := c.PreviousBusinessDay(Date{2025, time.June, 16}), (Date{2025, time.June, 14}); got != want {
+		t.Errorf("PreviousBusinessDay: want %s, got %s", want, got)
+	}
+	if got, want := c.BusinessDaysBetween(Date{2025, time.June, 13}, Date{2025, time.June, 16}), 2; got != want {
+		t.Errorf("BusinessDaysBetween: want %d, got %d", want, got)
+	}
+}
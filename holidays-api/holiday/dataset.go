@@ -0,0 +1,188 @@
+package holiday
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/text/encoding/japanese"
+	"golang.org/x/text/transform"
+)
+
+// Dataset is a table of holidays published by the Cabinet Office.
+// The holidays out of the year range of the table are calculated based on the law.
+type Dataset struct {
+	holidays  []Holiday
+	startYear int
+	endYear   int
+}
+
+// the dataset that is generated from syukujitsu.csv by the updater.
+var defaultDataset = &Dataset{
+	holidays:  holidays,
+	startYear: holidaysStartYear,
+	endYear:   holidaysEndYear,
+}
+
+// the dataset that is used by the package-level functions.
+// nil means defaultDataset.
+var currentDataset atomic.Pointer[Dataset]
+
+// DefaultDataset returns the dataset bundled in the package.
+func DefaultDataset() *Dataset {
+	return defaultDataset
+}
+
+// CurrentDataset returns the dataset that is currently used.
+func CurrentDataset() *Dataset {
+	return loadDataset()
+}
+
+// SetDataset replaces the dataset that is used by the package-level functions and the calendars.
+// It is safe to call SetDataset while other goroutines are looking up holidays.
+// If ds is nil, it restores the dataset bundled in the package.
+func SetDataset(ds *Dataset) {
+	currentDataset.Store(ds)
+}
+
+func loadDataset() *Dataset {
+	if ds := currentDataset.Load(); ds != nil {
+		return ds
+	}
+	return defaultDataset
+}
+
+// StartYear returns the first year of the table.
+func (ds *Dataset) StartYear() int {
+	return ds.startYear
+}
+
+// EndYear returns the last year of the table.
+func (ds *Dataset) EndYear() int {
+	return ds.endYear
+}
+
+// Holidays returns the holidays in the table.
+func (ds *Dataset) Holidays() []Holiday {
+	return slices.Clone(ds.holidays)
+}
+
+// contains reports whether the table covers the year.
+func (ds *Dataset) contains(year int) bool {
+	return ds.startYear <= year && year <= ds.endYear
+}
+
+// ParseCSV parses syukujitsu.csv published by the Cabinet Office.
+// The CSV is encoded in Shift_JIS, and its first line is the header.
+//
+//	国民の祝日・休日月日,国民の祝日・休日名称
+//	1955/1/1,元日
+//	1955/1/15,成人の日
+//
+// See https://www8.cao.go.jp/chosei/shukujitsu/gaiyou.html
+func ParseCSV(r io.Reader) (*Dataset, error) {
+	reader := transform.NewReader(r, japanese.ShiftJIS.NewDecoder())
+	csvReader := csv.NewReader(reader)
+	csvReader.FieldsPerRecord = 2
+
+	// skip 国民の祝日・休日月日,国民の祝日・休日名称 line
+	if _, err := csvReader.Read(); err != nil {
+		return nil, fmt.Errorf("holiday: failed to parse csv: %w", err)
+	}
+
+	var holidays []Holiday
+	for {
+		record, err := csvReader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("holiday: failed to parse csv: %w", err)
+		}
+		d, err := parseSlashDate(record[0])
+		if err != nil {
+			return nil, err
+		}
+		holidays = append(holidays, Holiday{
+			Date: d.String(),
+			Name: record[1],
+		})
+	}
+	if len(holidays) == 0 {
+		return nil, errors.New("holiday: failed to parse csv: no holidays")
+	}
+	sort.Sort(withDate(holidays))
+	classifyHolidays(holidays)
+
+	return &Dataset{
+		holidays:  holidays,
+		startYear: mustParseDate(holidays[0].Date).Year(),
+		endYear:   mustParseDate(holidays[len(holidays)-1].Date).Year(),
+	}, nil
+}
+
+// LoadCSVFile loads syukujitsu.csv published by the Cabinet Office from the file.
+func LoadCSVFile(name string) (*Dataset, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ParseCSV(f)
+}
+
+// 2021/1/1 -> 2021-01-01
+func parseSlashDate(s string) (Date, error) {
+	y, rest, ok1 := strings.Cut(s, "/")
+	m, d, ok2 := strings.Cut(rest, "/")
+	if !ok1 || !ok2 {
+		return Date{}, fmt.Errorf("holiday: invalid date in csv: %q", s)
+	}
+	year, err1 := strconv.Atoi(y)
+	month, err2 := strconv.Atoi(m)
+	day, err3 := strconv.Atoi(d)
+	date := Date{year, time.Month(month), day}
+	if err1 != nil || err2 != nil || err3 != nil || year < 1 || year > 9999 || !date.isValid() {
+		return Date{}, fmt.Errorf("holiday: invalid date in csv: %q", s)
+	}
+	return date, nil
+}
+
+// classifyHolidays fills the Kind, ID and Kana fields of the holidays sorted by date.
+// The updater has its own copy of it to generate holidays_generated.go, and its test checks that they agree.
+func classifyHolidays(holidays []Holiday) {
+	isHoliday := make(map[string]bool, len(holidays))
+	for _, h := range holidays {
+		isHoliday[h.Date] = true
+	}
+
+	for i, h := range holidays {
+		switch h.Name {
+		case "結婚の儀", "大喪の礼", "即位礼正殿の儀":
+			holidays[i].Kind = KindImperialCeremony
+		case "休日（祝日扱い）":
+			holidays[i].Kind = KindSpecial
+		case "休日":
+			// a substitute holiday follows consecutive holidays that include a Sunday.
+			// otherwise, it is a citizens' holiday sandwiched between two holidays.
+			holidays[i].Kind = KindCitizens
+			d := mustParseDate(h.Date)
+			for d = d.AddDate(0, 0, -1); isHoliday[d.Format(dateLayout)]; d = d.AddDate(0, 0, -1) {
+				if d.Weekday() == time.Sunday {
+					holidays[i].Kind = KindSubstitute
+					break
+				}
+			}
+		}
+		holidays[i].ID = holidayID(holidays[i])
+		holidays[i].Kana = holidayKanas[h.Name]
+	}
+}
@@ -0,0 +1,129 @@
+package holiday
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"golang.org/x/text/encoding/japanese"
+)
+
+func TestLoadCSVFile(t *testing.T) {
+	// syukujitsu.csv and holidays_generated.go are updated by the updater at the same time,
+	// so they must have the same holidays.
+	ds, err := LoadCSVFile("syukujitsu.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ds.StartYear() != holidaysStartYear || ds.EndYear() != holidaysEndYear {
+		t.Errorf("want %d-%d, got %d-%d", holidaysStartYear, holidaysEndYear, ds.StartYear(), ds.EndYear())
+	}
+	if !reflect.DeepEqual(ds.Holidays(), holidays) {
+		t.Error("the holidays in syukujitsu.csv and holidays_generated.go are different")
+	}
+}
+
+func encodeShiftJIS(t *testing.T, s string) string {
+	t.Helper()
+	ret, err := japanese.ShiftJIS.NewEncoder().String(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return ret
+}
+
+func TestParseCSV(t *testing.T) {
+	input := encodeShiftJIS(t, "国民の祝日・休日月日,国民の祝日・休日名称\r\n"+
+		"2100/1/3,テスト\r\n"+
+		"2100/1/1,元日\r\n"+
+		"2100/1/2,休日\r\n")
+	ds, err := ParseCSV(strings.NewReader(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Holiday{
+		{Date: "2100-01-01", Name: "元日", ID: "new-years-day", Kana: "がんじつ"},
+		{Date: "2100-01-02", Name: "休日", Kind: KindCitizens, ID: "citizens-holiday", Kana: "きゅうじつ"},
+		{Date: "2100-01-03", Name: "テスト"},
+	}
+	if !reflect.DeepEqual(ds.Holidays(), want) {
+		t.Errorf("want %v, got %v", want, ds.Holidays())
+	}
+	if ds.StartYear() != 2100 || ds.EndYear() != 2100 {
+		t.Errorf("want 2100-2100, got %d-%d", ds.StartYear(), ds.EndYear())
+	}
+}
+
+func TestParseCSV_Invalid(t *testing.T) {
+	tests := []string{
+		"",
+		"国民の祝日・休日月日,国民の祝日・休日名称\r\n",
+		"国民の祝日・休日月日,国民の祝日・休日名称\r\n2100/2/30,invalid\r\n",
+		"国民の祝日・休日月日,国民の祝日・休日名称\r\n2100-01-01,invalid\r\n",
+		"国民の祝日・休日月日,国民の祝日・休日名称\r\n2100/1/1\r\n",
+	}
+	for _, input := range tests {
+		if _, err := ParseCSV(strings.NewReader(encodeShiftJIS(t, input))); err == nil {
+			t.Errorf("ParseCSV(%q): want error, got nil", input)
+		}
+	}
+}
+
+func TestSetDataset(t *testing.T) {
+	input := encodeShiftJIS(t, "国民の祝日・休日月日,国民の祝日・休日名称\r\n"+
+		"2100/1/1,元日\r\n"+
+		"2100/1/4,テスト\r\n")
+	ds, err := ParseCSV(strings.NewReader(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	SetDataset(ds)
+	t.Cleanup(func() { SetDataset(nil) })
+
+	if CurrentDataset() != ds {
+		t.Error("CurrentDataset: want the new dataset")
+	}
+	if h, ok := FindHoliday(2100, time.January, 4); !ok || h.Name != "テスト" {
+		t.Errorf("FindHoliday: want テスト, got %v, %t", h, ok)
+	}
+	if h, ok := FindHoliday(2100, time.January, 13); ok {
+		t.Errorf("FindHoliday: want no holiday, got %v", h)
+	}
+
+	// the years out of the dataset are calculated.
+	got := FindHolidaysInMonth(2099, time.January)
+	want := calcHolidaysInMonth(2099, time.January)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("FindHolidaysInMonth: want %v, got %v", want, got)
+	}
+
+	SetDataset(nil)
+	if CurrentDataset() != DefaultDataset() {
+		t.Error("CurrentDataset: want the default dataset")
+	}
+}
+
+func TestSetDataset_Concurrent(t *testing.T) {
+	ds, err := LoadCSVFile("syukujitsu.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { SetDataset(nil) })
+
+	var wg sync.WaitGroup
+	for range 4 {
+		wg.Go(func() {
+			for range 100 {
+				FindHolidaysInYear(2025)
+			}
+		})
+	}
+	for range 100 {
+		SetDataset(ds)
+		SetDataset(nil)
+	}
+	wg.Wait()
+}
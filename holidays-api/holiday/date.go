@@ -0,0 +1,168 @@
+package holiday
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+var errInvalidDateFormat = errors.New("holiday: invalid date format")
+
+// Date represents a date.
+type Date struct {
+	Year  int
+	Month time.Month
+	Day   int
+}
+
+// FromTime returns the date of t in the location of t.
+func FromTime(t time.Time) Date {
+	year, month, day := t.Date()
+	return Date{year, month, day}
+}
+
+// ParseDate parses a date in the "2006-01-02" format.
+func ParseDate(s string) (Date, error) {
+	y, s, ok := strings.Cut(s, "-")
+	if !ok {
+		return Date{}, errInvalidDateFormat
+	}
+	m, s, ok := strings.Cut(s, "-")
+	if !ok {
+		return Date{}, errInvalidDateFormat
+	}
+	d := s
+
+	year, err := parseDigits(y, 4)
+	if err != nil || year < 1 || year > 9999 {
+		return Date{}, errInvalidDateFormat
+	}
+	month, err := parseDigits(m, 2)
+	if err != nil || month < 1 || month > 12 {
+		return Date{}, errInvalidDateFormat
+	}
+	day, err := parseDigits(d, 2)
+	if err != nil || day < 1 || day > 31 {
+		return Date{}, errInvalidDateFormat
+	}
+	date := Date{
+		Year:  year,
+		Month: time.Month(month),
+		Day:   day,
+	}
+	if !date.isValid() {
+		// e.g. 2021-02-31
+		return Date{}, errInvalidDateFormat
+	}
+	return date, nil
+}
+
+func parseDigits(s string, digits int) (int, error) {
+	if len(s) != digits {
+		return 0, errInvalidDateFormat
+	}
+
+	var ret int
+	for _, ch := range s {
+		if '0' <= ch && ch <= '9' {
+			ret = ret*10 + int(ch-'0')
+		} else {
+			return 0, errInvalidDateFormat
+		}
+	}
+	return ret, nil
+}
+
+// Time returns the midnight at the beginning of d in the loc.
+func (d Date) Time(loc *time.Location) time.Time {
+	return time.Date(d.Year, d.Month, d.Day, 0, 0, 0, 0, loc)
+}
+
+// Compare compares d and b.
+// It returns -1 if d is before b, +1 if d is after b, and 0 if they are the same date.
+func (d Date) Compare(b Date) int {
+	if d.Year != b.Year {
+		return cmp.Compare(d.Year, b.Year)
+	}
+	if d.Month != b.Month {
+		return cmp.Compare(d.Month, b.Month)
+	}
+	return cmp.Compare(d.Day, b.Day)
+}
+
+// Before reports whether d is before b.
+func (d Date) Before(b Date) bool {
+	return d.Compare(b) < 0
+}
+
+// After reports whether d is after b.
+func (d Date) After(b Date) bool {
+	return d.Compare(b) > 0
+}
+
+func (d Date) String() string {
+	return fmt.Sprintf("%04d-%02d-%02d", d.Year, int(d.Month), d.Day)
+}
+
+// MarshalText implements the [encoding.TextMarshaler] interface.
+// The date is formatted in the "2006-01-02" format.
+func (d Date) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface.
+// The date must be in the "2006-01-02" format.
+func (d *Date) UnmarshalText(data []byte) error {
+	v, err := ParseDate(string(data))
+	if err != nil {
+		return err
+	}
+	*d = v
+	return nil
+}
+
+// isValid reports whether d is a valid date.
+// e.g. February 30 is not valid.
+func (d Date) isValid() bool {
+	return d.AddDays(0) == d
+}
+
+// firstDay returns the first day of the month.
+func (d Date) firstDay() Date {
+	return Date{d.Year, d.Month, 1}
+}
+
+// lastDay returns the last day of the month.
+func (d Date) lastDay() Date {
+	return d.nextMonth().AddDays(-1)
+}
+
+// nextMonth returns the first day of the next month.
+func (d Date) nextMonth() Date {
+	if d.Month == time.December {
+		return Date{d.Year + 1, time.January, 1}
+	}
+	return Date{d.Year, d.Month + 1, 1}
+}
+
+// prevMonth returns the first day of the previous month.
+func (d Date) prevMonth() Date {
+	if d.Month == time.January {
+		return Date{d.Year - 1, time.December, 1}
+	}
+	return Date{d.Year, d.Month - 1, 1}
+}
+
+// AddDays returns the date n days after d.
+// If n is negative, it returns the date -n days before d.
+func (d Date) AddDays(n int) Date {
+	t := time.Date(d.Year, d.Month, d.Day+n, 0, 0, 0, 0, time.UTC)
+	return Date{t.Year(), t.Month(), t.Day()}
+}
+
+// Weekday returns the day of the week specified by d.
+func (d Date) Weekday() time.Weekday {
+	return time.Date(d.Year, d.Month, d.Day, 0, 0, 0, 0, time.UTC).Weekday()
+}
@@ -0,0 +1,154 @@
+package holiday
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestFromTime(t *testing.T) {
+	jst := time.FixedZone("Asia/Tokyo", 9*60*60)
+	tm := time.Date(2000, time.December, 31, 23, 0, 0, 0, time.UTC)
+
+	if got, want := FromTime(tm), (Date{2000, time.December, 31}); got != want {
+		t.Errorf("want %s, got %s", want, got)
+	}
+	if got, want := FromTime(tm.In(jst)), (Date{2001, time.January, 1}); got != want {
+		t.Errorf("want %s, got %s", want, got)
+	}
+}
+
+func TestDate_Time(t *testing.T) {
+	jst := time.FixedZone("Asia/Tokyo", 9*60*60)
+	d := Date{2001, time.January, 1}
+	got := d.Time(jst)
+	want := time.Date(2000, time.December, 31, 15, 0, 0, 0, time.UTC)
+	if !got.Equal(want) {
+		t.Errorf("want %s, got %s", want, got)
+	}
+	if FromTime(got) != d {
+		t.Errorf("want %s, got %s", d, FromTime(got))
+	}
+}
+
+func TestDate_AddDays(t *testing.T) {
+	tests := []struct {
+		date Date
+		n    int
+		want Date
+	}{
+		{Date{2000, time.January, 1}, 0, Date{2000, time.January, 1}},
+		{Date{2000, time.February, 28}, 1, Date{2000, time.February, 29}},
+		{Date{2000, time.December, 31}, 1, Date{2001, time.January, 1}},
+		{Date{2001, time.January, 1}, -1, Date{2000, time.December, 31}},
+		{Date{2000, time.January, 1}, 366, Date{2001, time.January, 1}},
+	}
+	for _, tt := range tests {
+		if got := tt.date.AddDays(tt.n); got != tt.want {
+			t.Errorf("%s.AddDays(%d): want %s, got %s", tt.date, tt.n, tt.want, got)
+		}
+	}
+}
+
+func TestDate_Weekday(t *testing.T) {
+	if got, want := (Date{2000, time.January, 1}).Weekday(), time.Saturday; got != want {
+		t.Errorf("want %s, got %s", want, got)
+	}
+}
+
+func TestDate_Compare(t *testing.T) {
+	a := Date{2000, time.January, 1}
+	b := Date{2000, time.January, 2}
+	c := Date{2000, time.February, 1}
+	d := Date{2001, time.January, 1}
+
+	if a.Compare(a) != 0 {
+		t.Errorf("%s must be equal to itself", a)
+	}
+	for _, x := range []Date{b, c, d} {
+		if a.Compare(x) >= 0 || x.Compare(a) <= 0 {
+			t.Errorf("%s must be before %s", a, x)
+		}
+		if !a.Before(x) || a.After(x) {
+			t.Errorf("%s must be before %s", a, x)
+		}
+		if !x.After(a) || x.Before(a) {
+			t.Errorf("%s must be after %s", x, a)
+		}
+	}
+}
+
+func TestParseDate(t *testing.T) {
+	tests := []struct {
+		in   string
+		want Date
+		err  bool
+	}{
+		{in: "2006-01-02", want: Date{2006, time.January, 2}},
+		{in: "0001-12-31", want: Date{1, time.December, 31}},
+		{in: "0000-01-01", err: true},
+		{in: "2006-13-01", err: true},
+		{in: "2006-01-32", err: true},
+		{in: "2021-02-31", err: true},
+		{in: "2021-04-31", err: true},
+		{in: "2023-02-29", err: true},
+		{in: "2024-02-29", want: Date{2024, time.February, 29}},
+		{in: "2006-1-2", err: true},
+		{in: "2006/01/02", err: true},
+		{in: "2006-01-02-03", err: true},
+		{in: "", err: true},
+	}
+	for _, tt := range tests {
+		got, err := ParseDate(tt.in)
+		if (err != nil) != tt.err {
+			t.Errorf("%q: unexpected error: %v", tt.in, err)
+		}
+		if got != tt.want {
+			t.Errorf("%q: want %s, got %s", tt.in, tt.want, got)
+		}
+	}
+}
+
+func TestDate_JSON(t *testing.T) {
+	type value struct {
+		Date Date `json:"date"`
+	}
+
+	data, err := json.Marshal(value{Date{2006, time.January, 2}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := string(data), `{"date":"2006-01-02"}`; got != want {
+		t.Errorf("want %s, got %s", want, got)
+	}
+
+	var v value
+	if err := json.Unmarshal(data, &v); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := v.Date, (Date{2006, time.January, 2}); got != want {
+		t.Errorf("want %s, got %s", want, got)
+	}
+
+	if err := json.Unmarshal([]byte(`{"date":"2006-1-2"}`), &v); err == nil {
+		t.Error("want error, got nil")
+	}
+}
+
+func FuzzParseDate(f *testing.F) {
+	f.Add("2006-01-02")
+	f.Fuzz(func(t *testing.T, date string) {
+		d0, err := ParseDate(date)
+		if err != nil {
+			return
+		}
+		s0 := d0.String()
+		d1, err := ParseDate(s0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if d0 != d1 {
+			t.Errorf("unexpected date: want %s, got %s", d0, d1)
+		}
+	})
+}
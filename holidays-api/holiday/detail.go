@@ -0,0 +1,80 @@
+package holiday
+
+// HolidayDetail is the legal basis of a national holiday.
+type HolidayDetail struct {
+	// Purpose is the purpose (趣旨) of the holiday quoted from the law.
+	// e.g. "山に親しむ機会を得て、山の恩恵に感謝する。"
+	Purpose string
+
+	// Laws are the laws that set the holiday in the year:
+	// the National Holiday Law and the amendments that added, moved or renamed the holiday.
+	Laws []Law
+
+	// EstablishedYear is the year that the law establishing the holiday was enacted.
+	// e.g. 2014 for 山の日
+	EstablishedYear int
+}
+
+// Detail returns the legal basis of the holiday.
+// It returns false if h is not a national holiday defined by the National Holiday Law.
+func (h Holiday) Detail() (HolidayDetail, bool) {
+	return national.Detail(h)
+}
+
+// Detail returns the legal basis of the holiday based on the rules of c.
+// The calendar returned by AsOf cites only the laws published by the time.
+// It returns false if h is not a national holiday defined by the National Holiday Law.
+func (c *Calendar) Detail(h Holiday) (HolidayDetail, bool) {
+	rs := c.rules
+	if rs == nil {
+		rs = loadRuleSet()
+	}
+	return rs.detail(h)
+}
+
+func (rs *RuleSet) detail(h Holiday) (HolidayDetail, bool) {
+	if h.Kind != KindNational {
+		return HolidayDetail{}, false
+	}
+	d, err := ParseDate(h.Date)
+	if err != nil {
+		return HolidayDetail{}, false
+	}
+	id := holidayID(h)
+	established, ok := rs.established[id]
+	if !ok {
+		return HolidayDetail{}, false
+	}
+
+	// search the rule of this year
+	rule := rs.ruleOf(d.Year)
+	if rule == nil {
+		return HolidayDetail{}, false
+	}
+
+	detail := HolidayDetail{
+		EstablishedYear: established,
+	}
+	for _, e := range rule.EquinoxHolydays {
+		if e.ID == id {
+			detail.Purpose = e.Purpose
+			detail.Laws = e.Laws
+			return detail, true
+		}
+	}
+	for _, s := range rule.StaticHolydays {
+		if s.ID == id {
+			detail.Purpose = s.Purpose
+			detail.Laws = s.Laws
+			return detail, true
+		}
+	}
+	for _, w := range rule.WeekdayHolydays {
+		if w.ID == id {
+			detail.Purpose = w.Purpose
+			detail.Laws = w.Laws
+			return detail, true
+		}
+	}
+	return HolidayDetail{}, false
+}
@@ -0,0 +1,136 @@
+package holiday
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestHoliday_Detail(t *testing.T) {
+	tests := []struct {
+		date        Date
+		purpose     string
+		laws        []string
+		established int
+	}{
+		{Date{2025, time.August, 11}, "山に親しむ機会を得て、山の恩恵に感謝する。", []string{"昭和二十三年法律第百七十八号", "平成二十六年法律第四十三号"}, 2014},
+		{Date{2025, time.March, 20}, "自然をたたえ、生物をいつくしむ。", []string{"昭和二十三年法律第百七十八号"}, 1948},
+		{Date{2025, time.September, 23}, "祖先をうやまい、なくなつた人々をしのぶ。", []string{"昭和二十三年法律第百七十八号"}, 1948},
+		{Date{2025, time.January, 13}, "おとなになつたことを自覚し、みずから生き抜こうとする青年を祝いはげます。", []string{"昭和二十三年法律第百七十八号", "平成十年法律第百四十一号"}, 1948},
+
+		// 体育の日 was renamed to スポーツの日 in 2020, and the purpose was changed.
+		{Date{2019, time.October, 14}, "スポーツにしたしみ、健康な心身をつちかう。", []string{"昭和二十三年法律第百七十八号", "平成十年法律第百四十一号", "平成三十年法律第五十七号"}, 1966},
+		{Date{2020, time.July, 24}, "スポーツを楽しみ、他者を尊重する精神を培うとともに、健康で活力ある社会の実現を願う。", []string{"昭和二十三年法律第百七十八号", "平成三十年法律第五十七号", "平成三十年法律第五十五号"}, 1966},
+
+		// calculated holidays
+		{Date{2100, time.May, 4}, "自然に親しむとともにその恩恵に感謝し、豊かな心をはぐくむ。", []string{"昭和二十三年法律第百七十八号", "平成十七年法律第四十三号"}, 1989},
+	}
+	for _, tt := range tests {
+		h, ok := FindHoliday(tt.date.Year, tt.date.Month, tt.date.Day)
+		if !ok {
+			t.Errorf("%s: not a holiday", tt.date)
+			continue
+		}
+		detail, ok := h.Detail()
+		if !ok {
+			t.Errorf("%s: no detail", tt.date)
+			continue
+		}
+		if detail.Purpose != tt.purpose {
+			t.Errorf("%s: want the purpose %q, got %q", tt.date, tt.purpose, detail.Purpose)
+		}
+		var laws []string
+		for _, law := range detail.Laws {
+			laws = append(laws, law.Number)
+		}
+		if !slices.Equal(laws, tt.laws) {
+			t.Errorf("%s: want the laws %v, got %v", tt.date, tt.laws, laws)
+		}
+		if detail.EstablishedYear != tt.established {
+			t.Errorf("%s: want the established year %d, got %d", tt.date, tt.established, detail.EstablishedYear)
+		}
+	}
+}
+
+func TestCalendar_Detail_AsOf(t *testing.T) {
+	// the law published on 2020-12-04 moved スポーツの日 in 2021 to July 23.
+	c := AsOf(time.Date(2020, time.December, 3, 0, 0, 0, 0, jst))
+	h, ok := c.FindHoliday(2021, time.October, 11)
+	if !ok {
+		t.Fatal("2021-10-11: not a holiday")
+	}
+	detail, ok := c.Detail(h)
+	if !ok {
+		t.Fatal("2021-10-11: no detail")
+	}
+	var laws []string
+	for _, law := range detail.Laws {
+		laws = append(laws, law.Number)
+	}
+	want := []string{"昭和二十三年法律第百七十八号", "平成十年法律第百四十一号", "平成三十年法律第五十七号"}
+	if !slices.Equal(laws, want) {
+		t.Errorf("want the laws %v, got %v", want, laws)
+	}
+
+	// the current calendar cites the law.
+	h, _ = FindHoliday(2021, time.July, 23)
+	detail, _ = h.Detail()
+	if !slices.ContainsFunc(detail.Laws, func(law Law) bool { return law.Number == "令和二年法律第六十八号" }) {
+		t.Errorf("want 令和二年法律第六十八号, got %v", detail.Laws)
+	}
+}
+
+func TestHoliday_Detail_NotNational(t *testing.T) {
+	for _, d := range []Date{
+		{2025, time.May, 6},       // substitute holiday
+		{2019, time.May, 1},       // special holiday
+		{1989, time.February, 24}, // imperial ceremony
+	} {
+		h, ok := FindHoliday(d.Year, d.Month, d.Day)
+		if !ok {
+			t.Errorf("%s: not a holiday", d)
+			continue
+		}
+		if _, ok := h.Detail(); ok {
+			t.Errorf("%s: want no detail", d)
+		}
+	}
+}
+
+func TestHoliday_Detail_AllRules(t *testing.T) {
+	for _, rule := range defaultRuleSet.rules {
+		for _, d := range rule.StaticHolydays {
+			if d.Purpose == "" {
+				t.Errorf("%d %s: no purpose", rule.BeginYear, d.Name)
+			}
+			if len(d.Laws) == 0 || d.Laws[0].Number != "昭和二十三年法律第百七十八号" {
+				t.Errorf("%d %s: want the laws starting with the National Holiday Law, got %v", rule.BeginYear, d.Name, d.Laws)
+			}
+			if _, ok := defaultRuleSet.established[d.ID]; !ok {
+				t.Errorf("%d %s: no established year", rule.BeginYear, d.Name)
+			}
+		}
+		for _, d := range rule.WeekdayHolydays {
+			if d.Purpose == "" {
+				t.Errorf("%d %s: no purpose", rule.BeginYear, d.Name)
+			}
+			if len(d.Laws) == 0 || d.Laws[0].Number != "昭和二十三年法律第百七十八号" {
+				t.Errorf("%d %s: want the laws starting with the National Holiday Law, got %v", rule.BeginYear, d.Name, d.Laws)
+			}
+			if _, ok := defaultRuleSet.established[d.ID]; !ok {
+				t.Errorf("%d %s: no established year", rule.BeginYear, d.Name)
+			}
+		}
+		for _, d := range rule.EquinoxHolydays {
+			if d.Purpose == "" {
+				t.Errorf("%d %s: no purpose", rule.BeginYear, d.Name)
+			}
+			if len(d.Laws) == 0 || d.Laws[0].Number != "昭和二十三年法律第百七十八号" {
+				t.Errorf("%d %s: want the laws starting with the National Holiday Law, got %v", rule.BeginYear, d.Name, d.Laws)
+			}
+			if _, ok := defaultRuleSet.established[d.ID]; !ok {
+				t.Errorf("%d %s: no established year", rule.BeginYear, d.Name)
+			}
+		}
+	}
+}
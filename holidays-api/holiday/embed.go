@@ -0,0 +1,23 @@
+//go:build holidays_embed_csv
+
+package holiday
+
+import (
+	"bytes"
+	_ "embed"
+)
+
+// syukujitsu.csv is a copy of the CSV published by the Cabinet Office.
+// Build with the holidays_embed_csv tag to use it as the default dataset
+// instead of the table generated by the updater.
+//
+//go:embed syukujitsu.csv
+var embeddedCSV []byte
+
+func init() {
+	ds, err := ParseCSV(bytes.NewReader(embeddedCSV))
+	if err != nil {
+		panic(err)
+	}
+	defaultDataset = ds
+}
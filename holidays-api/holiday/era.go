@@ -0,0 +1,289 @@
+package holiday
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+var errInvalidJapaneseDateFormat = errors.New("holiday: invalid japanese date format")
+
+// Era is an era of the Japanese calendar (元号).
+type Era int
+
+const (
+	// EraNone means that the date is before the Meiji era.
+	EraNone Era = iota
+
+	// EraMeiji is the Meiji era (明治), from 1868-10-23 (明治元年10月23日).
+	// The Gregorian calendar was adopted on 1873-01-01 (明治6年1月1日),
+	// so the dates before that are the dates in the Gregorian calendar, not the lunisolar calendar used at that time.
+	EraMeiji
+
+	// EraTaisho is the Taisho era (大正), from 1912-07-30 (大正元年7月30日).
+	EraTaisho
+
+	// EraShowa is the Showa era (昭和), from 1926-12-25 (昭和元年12月25日).
+	EraShowa
+
+	// EraHeisei is the Heisei era (平成), from 1989-01-08 (平成元年1月8日).
+	EraHeisei
+
+	// EraReiwa is the Reiwa era (令和), from 2019-05-01 (令和元年5月1日).
+	EraReiwa
+)
+
+type eraInfo struct {
+	start        Date
+	kanji        string
+	romaji       string
+	abbreviation string
+}
+
+var eras = [...]eraInfo{
+	EraMeiji: {
+		start:        Date{1868, time.October, 23},
+		kanji:        "明治",
+		romaji:       "Meiji",
+		abbreviation: "M",
+	},
+	EraTaisho: {
+		start:        Date{1912, time.July, 30},
+		kanji:        "大正",
+		romaji:       "Taisho",
+		abbreviation: "T",
+	},
+	EraShowa: {
+		start:        Date{1926, time.December, 25},
+		kanji:        "昭和",
+		romaji:       "Showa",
+		abbreviation: "S",
+	},
+	EraHeisei: {
+		start:        Date{1989, time.January, 8},
+		kanji:        "平成",
+		romaji:       "Heisei",
+		abbreviation: "H",
+	},
+	EraReiwa: {
+		start:        Date{2019, time.May, 1},
+		kanji:        "令和",
+		romaji:       "Reiwa",
+		abbreviation: "R",
+	},
+}
+
+func (e Era) valid() bool {
+	return EraMeiji <= e && int(e) < len(eras)
+}
+
+// String returns the name of the era in the Latin alphabet. e.g. "Reiwa"
+func (e Era) String() string {
+	if e == EraNone {
+		return "None"
+	}
+	if !e.valid() {
+		return "Era(" + strconv.Itoa(int(e)) + ")"
+	}
+	return eras[e].romaji
+}
+
+// LocalizedName returns the name of the era in the language.
+// e.g. "令和" in Japanese and "Reiwa" in English.
+// It returns an empty string for EraNone.
+func (e Era) LocalizedName(lang Language) string {
+	if !e.valid() {
+		return ""
+	}
+	if lang == Japanese {
+		return eras[e].kanji
+	}
+	return eras[e].romaji
+}
+
+// Abbreviation returns the abbreviation of the era. e.g. "R" for Reiwa.
+// It returns an empty string for EraNone.
+func (e Era) Abbreviation() string {
+	if !e.valid() {
+		return ""
+	}
+	return eras[e].abbreviation
+}
+
+// Start returns the first day of the era.
+func (e Era) Start() Date {
+	if !e.valid() {
+		return Date{}
+	}
+	return eras[e].start
+}
+
+// Contains reports whether d is in the era.
+func (e Era) Contains(d Date) bool {
+	if !e.valid() {
+		return false
+	}
+	if d.Before(eras[e].start) {
+		return false
+	}
+	if int(e)+1 < len(eras) && !d.Before(eras[e+1].start) {
+		return false
+	}
+	return true
+}
+
+// GregorianYear returns the year in the Gregorian calendar of the year in the era.
+// It returns false if the era doesn't have the year.
+func (e Era) GregorianYear(year int) (int, bool) {
+	if !e.valid() || year < 1 {
+		return 0, false
+	}
+	ret := eras[e].start.Year + year - 1
+	if int(e)+1 < len(eras) && ret > eras[e+1].start.Year {
+		return 0, false
+	}
+	if ret > 9999 {
+		return 0, false
+	}
+	return ret, true
+}
+
+// JapaneseDate is a date in the Japanese calendar (和暦).
+type JapaneseDate struct {
+	Era   Era
+	Year  int // the year in the era. 1 is 元年.
+	Month time.Month
+	Day   int
+}
+
+// Japanese converts d to the Japanese calendar.
+// It returns false if d is before the Meiji era.
+func (d Date) Japanese() (JapaneseDate, bool) {
+	for e := Era(len(eras) - 1); e >= EraMeiji; e-- {
+		if !d.Before(eras[e].start) {
+			return JapaneseDate{
+				Era:   e,
+				Year:  d.Year - eras[e].start.Year + 1,
+				Month: d.Month,
+				Day:   d.Day,
+			}, true
+		}
+	}
+	return JapaneseDate{}, false
+}
+
+// Date converts jd to the Gregorian calendar.
+func (jd JapaneseDate) Date() Date {
+	year, _ := jd.Era.GregorianYear(jd.Year)
+	return Date{year, jd.Month, jd.Day}
+}
+
+// String returns the date in the "令和7年5月3日" format.
+// The first year of the era is formatted as "元年".
+func (jd JapaneseDate) String() string {
+	var buf strings.Builder
+	buf.WriteString(jd.Era.LocalizedName(Japanese))
+	if jd.Year == 1 {
+		buf.WriteString("元")
+	} else {
+		buf.WriteString(strconv.Itoa(jd.Year))
+	}
+	buf.WriteString("年")
+	buf.WriteString(strconv.Itoa(int(jd.Month)))
+	buf.WriteString("月")
+	buf.WriteString(strconv.Itoa(jd.Day))
+	buf.WriteString("日")
+	return buf.String()
+}
+
+// ParseEra parses the name of an era: the kanji ("令和"), the name in the Latin alphabet ("Reiwa")
+// or the abbreviation ("R"). The Latin alphabet is case-insensitive.
+func ParseEra(s string) (Era, bool) {
+	for e := EraMeiji; int(e) < len(eras); e++ {
+		info := eras[e]
+		if s == info.kanji || strings.EqualFold(s, info.romaji) || strings.EqualFold(s, info.abbreviation) {
+			return e, true
+		}
+	}
+	return EraNone, false
+}
+
+// ParseJapaneseDate parses a date in the Japanese calendar.
+// It accepts the "令和7年5月3日" format, where the year may be "元", and
+// the "R7.5.3" format, where the separator is one of ".", "/" and "-".
+// Full-width digits are also accepted.
+// It returns an error if the date is not in the era.
+func ParseJapaneseDate(s string) (Date, error) {
+	s = toHalfWidthDigits(s)
+
+	var era, year, month, day string
+	if r, _ := utf8.DecodeRuneInString(s); r < utf8.RuneSelf {
+		// R7.5.3
+		era, s = s[:1], s[1:]
+		var sep byte
+		if i := strings.IndexAny(s, "./-"); i >= 0 {
+			sep = s[i]
+		}
+		var ok1, ok2 bool
+		year, s, ok1 = strings.Cut(s, string(sep))
+		month, day, ok2 = strings.Cut(s, string(sep))
+		if sep == 0 || !ok1 || !ok2 {
+			return Date{}, errInvalidJapaneseDateFormat
+		}
+	} else {
+		// 令和7年5月3日
+		if len(s) < len("令和") {
+			return Date{}, errInvalidJapaneseDateFormat
+		}
+		era, s = s[:len("令和")], s[len("令和"):]
+		var ok1, ok2, ok3 bool
+		year, s, ok1 = strings.Cut(s, "年")
+		month, s, ok2 = strings.Cut(s, "月")
+		day, s, ok3 = strings.Cut(s, "日")
+		if !ok1 || !ok2 || !ok3 || s != "" {
+			return Date{}, errInvalidJapaneseDateFormat
+		}
+		if year == "元" {
+			year = "1"
+		}
+	}
+
+	e, ok := ParseEra(era)
+	if !ok {
+		return Date{}, errInvalidJapaneseDateFormat
+	}
+	y, err1 := parseSmallInt(year)
+	m, err2 := parseSmallInt(month)
+	d, err3 := parseSmallInt(day)
+	if err1 != nil || err2 != nil || err3 != nil {
+		return Date{}, errInvalidJapaneseDateFormat
+	}
+	gy, ok := e.GregorianYear(y)
+	if !ok {
+		return Date{}, errInvalidJapaneseDateFormat
+	}
+	date := Date{gy, time.Month(m), d}
+	if !date.isValid() || !e.Contains(date) {
+		return Date{}, errInvalidJapaneseDateFormat
+	}
+	return date, nil
+}
+
+// parseSmallInt parses one or two digits.
+func parseSmallInt(s string) (int, error) {
+	if len(s) != 1 && len(s) != 2 {
+		return 0, errInvalidJapaneseDateFormat
+	}
+	return parseDigits(s, len(s))
+}
+
+func toHalfWidthDigits(s string) string {
+	return strings.Map(func(r rune) rune {
+		if '０' <= r && r <= '９' {
+			return r - '０' + '0'
+		}
+		return r
+	}, s)
+}
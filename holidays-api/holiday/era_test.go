@@ -0,0 +1,152 @@
+package holiday
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDate_Japanese(t *testing.T) {
+	tests := []struct {
+		date Date
+		want JapaneseDate
+		ok   bool
+	}{
+		{Date{1868, time.October, 22}, JapaneseDate{}, false},
+		{Date{1868, time.October, 23}, JapaneseDate{EraMeiji, 1, time.October, 23}, true},
+		{Date{1912, time.July, 29}, JapaneseDate{EraMeiji, 45, time.July, 29}, true},
+		{Date{1912, time.July, 30}, JapaneseDate{EraTaisho, 1, time.July, 30}, true},
+		{Date{1926, time.December, 24}, JapaneseDate{EraTaisho, 15, time.December, 24}, true},
+		{Date{1926, time.December, 25}, JapaneseDate{EraShowa, 1, time.December, 25}, true},
+		{Date{1989, time.January, 7}, JapaneseDate{EraShowa, 64, time.January, 7}, true},
+		{Date{1989, time.January, 8}, JapaneseDate{EraHeisei, 1, time.January, 8}, true},
+		{Date{2019, time.April, 30}, JapaneseDate{EraHeisei, 31, time.April, 30}, true},
+		{Date{2019, time.May, 1}, JapaneseDate{EraReiwa, 1, time.May, 1}, true},
+		{Date{2025, time.May, 3}, JapaneseDate{EraReiwa, 7, time.May, 3}, true},
+	}
+	for _, tt := range tests {
+		got, ok := tt.date.Japanese()
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("%s: want %v, %t, got %v, %t", tt.date, tt.want, tt.ok, got, ok)
+			continue
+		}
+		if ok && got.Date() != tt.date {
+			t.Errorf("%s: round trip failed: got %s", tt.date, got.Date())
+		}
+	}
+}
+
+func TestJapaneseDate_String(t *testing.T) {
+	tests := []struct {
+		date JapaneseDate
+		want string
+	}{
+		{JapaneseDate{EraReiwa, 7, time.May, 3}, "令和7年5月3日"},
+		{JapaneseDate{EraReiwa, 1, time.May, 1}, "令和元年5月1日"},
+		{JapaneseDate{EraShowa, 64, time.January, 7}, "昭和64年1月7日"},
+	}
+	for _, tt := range tests {
+		if got := tt.date.String(); got != tt.want {
+			t.Errorf("want %q, got %q", tt.want, got)
+		}
+	}
+}
+
+func TestParseJapaneseDate(t *testing.T) {
+	tests := []struct {
+		input string
+		want  Date
+	}{
+		{"令和7年5月3日", Date{2025, time.May, 3}},
+		{"令和元年5月1日", Date{2019, time.May, 1}},
+		{"令和1年5月1日", Date{2019, time.May, 1}},
+		{"令和７年５月３日", Date{2025, time.May, 3}},
+		{"平成31年4月30日", Date{2019, time.April, 30}},
+		{"平成元年1月8日", Date{1989, time.January, 8}},
+		{"昭和64年1月7日", Date{1989, time.January, 7}},
+		{"R7.5.3", Date{2025, time.May, 3}},
+		{"R07/05/03", Date{2025, time.May, 3}},
+		{"r7-5-3", Date{2025, time.May, 3}},
+		{"H31.04.30", Date{2019, time.April, 30}},
+		{"M45.7.29", Date{1912, time.July, 29}},
+	}
+	for _, tt := range tests {
+		got, err := ParseJapaneseDate(tt.input)
+		if err != nil {
+			t.Errorf("%q: unexpected error: %v", tt.input, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("%q: want %s, got %s", tt.input, tt.want, got)
+		}
+	}
+}
+
+func TestParseJapaneseDate_Invalid(t *testing.T) {
+	tests := []string{
+		"",
+		"令和",
+		"令和7年5月3",
+		"令和7年5月3日です",
+		"令和0年5月1日",
+		"令和元年4月30日", // Heisei
+		"平成31年5月1日", // Reiwa
+		"昭和64年1月8日", // Heisei
+		"昭和65年1月1日",
+		"慶応4年1月1日",
+		"R7.2.29",
+		"R7/5.3",
+		"R753",
+		"X7.5.3",
+		"R100.1.1",
+	}
+	for _, input := range tests {
+		if got, err := ParseJapaneseDate(input); err == nil {
+			t.Errorf("%q: want error, got %s", input, got)
+		}
+	}
+}
+
+func TestParseEra(t *testing.T) {
+	tests := []struct {
+		input string
+		want  Era
+		ok    bool
+	}{
+		{"令和", EraReiwa, true},
+		{"Reiwa", EraReiwa, true},
+		{"reiwa", EraReiwa, true},
+		{"R", EraReiwa, true},
+		{"h", EraHeisei, true},
+		{"明治", EraMeiji, true},
+		{"慶応", EraNone, false},
+	}
+	for _, tt := range tests {
+		got, ok := ParseEra(tt.input)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("%q: want %v, %t, got %v, %t", tt.input, tt.want, tt.ok, got, ok)
+		}
+	}
+}
+
+func TestEra_GregorianYear(t *testing.T) {
+	tests := []struct {
+		era  Era
+		year int
+		want int
+		ok   bool
+	}{
+		{EraReiwa, 1, 2019, true},
+		{EraReiwa, 7, 2025, true},
+		{EraHeisei, 31, 2019, true},
+		{EraHeisei, 32, 0, false},
+		{EraShowa, 64, 1989, true},
+		{EraShowa, 0, 0, false},
+		{EraNone, 1, 0, false},
+	}
+	for _, tt := range tests {
+		got, ok := tt.era.GregorianYear(tt.year)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("%v %d: want %d, %t, got %d, %t", tt.era, tt.year, tt.want, tt.ok, got, ok)
+		}
+	}
+}
@@ -0,0 +1,196 @@
+package holiday
+
+import (
+	"fmt"
+	"time"
+)
+
+// Explanation is a trace of the rules that determine whether a date is a holiday.
+type Explanation struct {
+	// Date is the explained date.
+	Date Date
+
+	// Holiday is the holiday on the date.
+	// It is the zero value if the date is not a holiday.
+	Holiday Holiday
+
+	// IsHoliday reports whether the date is a holiday.
+	IsHoliday bool
+
+	// Source is where the holiday comes from.
+	// "table" for the table published by the Cabinet Office,
+	// and "calculation" for the calculation based on the law.
+	Source string
+
+	// BeginYear is the BeginYear of the rule that is enforced on the date.
+	// It is zero if no rule is enforced.
+	BeginYear int
+
+	// Steps are the clauses that are examined, in order.
+	Steps []ExplanationStep
+}
+
+// ExplanationStep is a clause examined by Explain.
+type ExplanationStep struct {
+	// Clause identifies the clause.
+	// One of "rule", "static", "weekday", "vernal-equinox", "autumnal-equinox",
+	// "special", "substitute", "citizens" and "table".
+	Clause string
+
+	// Description describes the clause.
+	Description string
+
+	// Matched reports whether the clause makes the date a holiday.
+	Matched bool
+
+	// Laws are the laws that define the clause.
+	Laws []Law
+}
+
+// Explain explains why d is or is not a holiday.
+func Explain(d Date) Explanation {
+	e := Explanation{
+		Date:   d,
+		Source: "calculation",
+	}
+
+	// search the rule of this year
+	rs := loadRuleSet()
+	rule := rs.ruleOf(d.Year)
+	if rule == nil {
+		e.Steps = append(e.Steps, ExplanationStep{
+			Clause:      "rule",
+			Description: fmt.Sprintf("no rule is enforced before %d", rs.startYear()),
+		})
+		e.explainTable()
+		return e
+	}
+	e.BeginYear = rule.BeginYear
+	e.Steps = append(e.Steps, ExplanationStep{
+		Clause:      "rule",
+		Description: fmt.Sprintf("the rule since %d is enforced", rule.BeginYear),
+		Laws:        rule.Laws,
+	})
+
+	// the national holidays
+	date := d.String()
+	for _, h := range rule.StaticHolydays {
+		if h.Date == date[len("2006-"):] {
+			e.Steps = append(e.Steps, ExplanationStep{
+				Clause:      "static",
+				Description: fmt.Sprintf("%s is on %s every year", h.Name, h.Date),
+				Matched:     true,
+				Laws:        h.Laws,
+			})
+		}
+	}
+	for _, h := range rule.WeekdayHolydays {
+		if h.Month != d.Month {
+			continue
+		}
+		matched := d.Weekday() == h.Weekday && (d.Day-1)/7 == h.Nth-1
+		if !matched {
+			continue
+		}
+		e.Steps = append(e.Steps, ExplanationStep{
+			Clause:      "weekday",
+			Description: fmt.Sprintf("%s is on the %s %s of %s", h.Name, ordinal(h.Nth), h.Weekday, h.Month),
+			Matched:     true,
+			Laws:        h.Laws,
+		})
+	}
+	for _, h := range rule.EquinoxHolydays {
+		switch {
+		case h.Equinox == equinoxVernal && d.Month == time.March:
+			day := vernalEquinoxDay(d.Year)
+			e.Steps = append(e.Steps, ExplanationStep{
+				Clause:      "vernal-equinox",
+				Description: fmt.Sprintf("%s is the vernal equinox day, that is calculated as March %d", h.Name, day),
+				Matched:     d.Day == day,
+				Laws:        h.Laws,
+			})
+		case h.Equinox == equinoxAutumnal && d.Month == time.September:
+			day := autumnalEquinoxDay(d.Year)
+			e.Steps = append(e.Steps, ExplanationStep{
+				Clause:      "autumnal-equinox",
+				Description: fmt.Sprintf("%s is the autumnal equinox day, that is calculated as September %d", h.Name, day),
+				Matched:     d.Day == day,
+				Laws:        h.Laws,
+			})
+		}
+	}
+	for _, h := range rs.special {
+		if h.Date == date {
+			e.Steps = append(e.Steps, ExplanationStep{
+				Clause:      "special",
+				Description: fmt.Sprintf("%s is a one-off holiday", h.Name),
+				Matched:     true,
+				Laws:        h.Laws,
+			})
+		}
+	}
+
+	// the holidays in lieu
+	holiday, ok := rs.findHoliday(d)
+	switch holiday.Kind {
+	case KindSubstitute:
+		sunday := d.AddDays(-1)
+		for sunday.Weekday() != time.Sunday {
+			sunday = sunday.AddDays(-1)
+		}
+		policy := rs.substitutePolicyOf(sunday.String())
+		description := fmt.Sprintf("the national holiday on Sunday %s is substituted by the next day", sunday)
+		if policy.Rule == substituteNearestNonHoliday {
+			description = fmt.Sprintf("the national holiday on Sunday %s is substituted by the nearest day that is not a national holiday", sunday)
+		}
+		e.Steps = append(e.Steps, ExplanationStep{
+			Clause:      "substitute",
+			Description: description,
+			Matched:     true,
+			Laws:        policy.Laws,
+		})
+	case KindCitizens:
+		policy := rs.citizensPolicyOf(date)
+		e.Steps = append(e.Steps, ExplanationStep{
+			Clause:      "citizens",
+			Description: fmt.Sprintf("the day is sandwiched between the national holidays on %s and %s", d.AddDays(-1), d.AddDays(1)),
+			Matched:     true,
+			Laws:        policy.Laws,
+		})
+	}
+	e.Holiday, e.IsHoliday = holiday, ok
+
+	e.explainTable()
+	return e
+}
+
+// explainTable overwrites the result with the table published by the Cabinet Office, if it covers the date.
+func (e *Explanation) explainTable() {
+	ds := loadDataset()
+	if !ds.contains(e.Date.Year) {
+		return
+	}
+
+	e.Source = "table"
+	h, ok := ds.findHoliday(e.Date.Year, e.Date.Month, e.Date.Day)
+	if h == e.Holiday && ok == e.IsHoliday {
+		return
+	}
+	e.Holiday, e.IsHoliday = h, ok
+	e.Steps = append(e.Steps, ExplanationStep{
+		Clause:      "table",
+		Description: "the table published by the Cabinet Office differs from the calculation",
+		Matched:     ok,
+	})
+}
+
+// findHoliday returns the holiday on d calculated based on the rules.
+func (rs *RuleSet) findHoliday(d Date) (Holiday, bool) {
+	date := d.String()
+	for _, h := range rs.holidaysInMonth(d.Year, d.Month) {
+		if h.Date == date {
+			return h, true
+		}
+	}
+	return Holiday{}, false
+}
@@ -0,0 +1,106 @@
+package holiday
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestExplain(t *testing.T) {
+	tests := []struct {
+		date      Date
+		isHoliday bool
+		source    string
+		beginYear int
+		clause    string
+		laws      []string
+	}{
+		{Date{2025, time.January, 1}, true, "table", 2022, "static", []string{"昭和二十三年法律第百七十八号"}},
+		{Date{2025, time.January, 13}, true, "table", 2022, "weekday", []string{"昭和二十三年法律第百七十八号", "平成十年法律第百四十一号"}},
+		{Date{2025, time.March, 20}, true, "table", 2022, "vernal-equinox", []string{"昭和二十三年法律第百七十八号"}},
+		{Date{2025, time.September, 23}, true, "table", 2022, "autumnal-equinox", []string{"昭和二十三年法律第百七十八号"}},
+		{Date{2025, time.May, 6}, true, "table", 2022, "substitute", []string{"平成十七年法律第四十三号"}},
+		{Date{1985, time.May, 6}, true, "table", 1967, "substitute", []string{"昭和四十八年法律第十号"}},
+		{Date{2015, time.September, 22}, true, "table", 2007, "citizens", []string{"昭和六十年法律第百三号", "平成十七年法律第四十三号"}},
+		{Date{2019, time.May, 1}, true, "table", 2019, "special", []string{"平成三十年法律第九十九号"}},
+		{Date{2021, time.July, 23}, true, "table", 2021, "static", []string{"昭和二十三年法律第百七十八号", "平成三十年法律第五十七号", "令和二年法律第六十八号"}},
+		{Date{2100, time.May, 4}, true, "calculation", 2022, "static", []string{"昭和二十三年法律第百七十八号", "平成十七年法律第四十三号"}},
+	}
+	for _, tt := range tests {
+		e := Explain(tt.date)
+		if e.IsHoliday != tt.isHoliday || e.Source != tt.source || e.BeginYear != tt.beginYear {
+			t.Errorf("%s: want %t, %q, %d, got %t, %q, %d", tt.date, tt.isHoliday, tt.source, tt.beginYear, e.IsHoliday, e.Source, e.BeginYear)
+			continue
+		}
+		var found bool
+		for _, step := range e.Steps {
+			if step.Clause == tt.clause && step.Matched {
+				found = true
+				var laws []string
+				for _, law := range step.Laws {
+					laws = append(laws, law.Number)
+				}
+				if !slices.Equal(laws, tt.laws) {
+					t.Errorf("%s: want the laws %v, got %v", tt.date, tt.laws, laws)
+				}
+			}
+		}
+		if !found {
+			t.Errorf("%s: want the clause %q, got %v", tt.date, tt.clause, e.Steps)
+		}
+	}
+}
+
+func TestExplain_NotHoliday(t *testing.T) {
+	e := Explain(Date{2025, time.March, 21})
+	if e.IsHoliday {
+		t.Errorf("want not a holiday, got %v", e.Holiday)
+	}
+	for _, step := range e.Steps {
+		if step.Matched {
+			t.Errorf("want no matched clauses, got %v", step)
+		}
+	}
+
+	e = Explain(Date{1900, time.January, 1})
+	if e.IsHoliday || e.BeginYear != 0 {
+		t.Errorf("want no rule, got %v", e)
+	}
+}
+
+func TestExplain_MatchesFindHoliday(t *testing.T) {
+	for d := (Date{2024, time.January, 1}); d.Year < 2031; d = d.AddDays(1) {
+		h, ok := FindHoliday(d.Year, d.Month, d.Day)
+		e := Explain(d)
+		if e.Holiday != h || e.IsHoliday != ok {
+			t.Errorf("%s: want %v, %t, got %v, %t", d, h, ok, e.Holiday, e.IsHoliday)
+		}
+	}
+}
+
+func TestExplain_RuleSet(t *testing.T) {
+	d := Date{holidaysEndYear + 3, time.May, 1}
+	rs := &RuleSet{
+		inLieu: defaultRuleSet.inLieu,
+		rules:  defaultRuleSet.rules,
+		special: []specialHoliday{
+			{Date: d.String(), Name: "休日（祝日扱い）", Kind: KindSpecial},
+		},
+	}
+	SetRuleSet(rs)
+	t.Cleanup(func() { SetRuleSet(nil) })
+
+	e := Explain(d)
+	if !e.IsHoliday || e.Holiday.Name != "休日（祝日扱い）" {
+		t.Errorf("want the special holiday, got %v, %t", e.Holiday, e.IsHoliday)
+	}
+	var matched bool
+	for _, step := range e.Steps {
+		if step.Clause == "special" && step.Matched {
+			matched = true
+		}
+	}
+	if !matched {
+		t.Errorf("want the special clause, got %v", e.Steps)
+	}
+}
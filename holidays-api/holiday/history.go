@@ -0,0 +1,234 @@
+package holiday
+
+import (
+	"bytes"
+	_ "embed"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// version is the rules that were known on and after the publication of laws.
+type version struct {
+	published string
+	rules     *RuleSet
+}
+
+// the versions of the rules in descending order of the publication date.
+var versions = mustVersions(defaultRuleSet)
+
+func mustVersions(rs *RuleSet) []version {
+	versions, err := rs.versions()
+	if err != nil {
+		panic(err)
+	}
+	return versions
+}
+
+// versions returns the rules known on the publication dates of the laws, in descending order of the date.
+func (rs *RuleSet) versions() ([]version, error) {
+	dates := make(map[string]bool)
+	for _, law := range rs.laws {
+		dates[law.Published] = true
+	}
+	published := make([]string, 0, len(dates))
+	for date := range dates {
+		published = append(published, date)
+	}
+	sort.Sort(sort.Reverse(sort.StringSlice(published)))
+
+	ret := make([]version, 0, len(published))
+	for _, date := range published {
+		v, err := rs.asOf(date)
+		if err != nil {
+			return nil, fmt.Errorf("holiday: invalid rules as of %s: %w", date, err)
+		}
+		ret = append(ret, version{
+			published: date,
+			rules:     v,
+		})
+	}
+	return ret, nil
+}
+
+// asOf returns the rules that cite only the laws published on or before the date.
+// The laws are not retroactive, so the amendments don't depend on the laws published later,
+// and the rules are merged in the same way as the rules file.
+func (rs *RuleSet) asOf(date string) (*RuleSet, error) {
+	known := func(laws []Law) bool {
+		for _, law := range laws {
+			if published := rs.laws[law.Number].Published; published == "" || published > date {
+				return false
+			}
+		}
+		return true
+	}
+
+	ret := &RuleSet{
+		inLieu: inLieuRules{
+			Substitute: slices.DeleteFunc(slices.Clone(rs.inLieu.Substitute), func(p substitutePolicy) bool { return !known(p.Laws) }),
+			Citizens:   slices.DeleteFunc(slices.Clone(rs.inLieu.Citizens), func(p citizensPolicy) bool { return !known(p.Laws) }),
+		},
+		special:    slices.DeleteFunc(slices.Clone(rs.special), func(d specialHoliday) bool { return !known(d.Laws) }),
+		amendments: slices.DeleteFunc(slices.Clone(rs.amendments), func(a ruleAmendment) bool { return !known(a.Laws) }),
+		laws:       rs.laws,
+	}
+	var err error
+	ret.rules, err = expandAmendments(ret.amendments)
+	if err != nil {
+		return nil, err
+	}
+	ret.established = establishedYears(ret.amendments, rs.laws)
+	return ret, nil
+}
+
+// history.yaml is the revisions of the table published by the Cabinet Office.
+// See the comments in the file for the format.
+//
+//go:embed history.yaml
+var historyYAML []byte
+
+// historyFile is the format of history.yaml.
+type historyFile struct {
+	Revisions []tableRevision `yaml:"revisions"`
+}
+
+// tableRevision is a revision of the table published by the Cabinet Office.
+type tableRevision struct {
+	// Published is the date that the revision was published in the "2006-01-02" format.
+	Published string `yaml:"published"`
+
+	// EndYear is the last year of the table after the revision.
+	EndYear int `yaml:"end_year"`
+
+	// Added are the dates of the holidays that the revision added.
+	Added []string `yaml:"added"`
+
+	// Removed are the holidays that the revision removed.
+	Removed []tableHoliday `yaml:"removed"`
+}
+
+type tableHoliday struct {
+	Date string `yaml:"date"`
+	Name string `yaml:"name"`
+}
+
+// tableVersion is the table that was known on and after the publication of a revision.
+type tableVersion struct {
+	published string
+	table     *Dataset
+}
+
+// the versions of the table in descending order of the publication date.
+// They are reproduced from the table generated by the updater.
+var tableVersions = mustTableVersions(historyYAML, defaultDataset)
+
+func mustTableVersions(data []byte, ds *Dataset) []tableVersion {
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+
+	var f historyFile
+	if err := dec.Decode(&f); err != nil {
+		panic(fmt.Errorf("holiday: failed to parse history: %w", err))
+	}
+	versions, err := ds.versions(f.Revisions)
+	if err != nil {
+		panic(err)
+	}
+	return versions
+}
+
+// versions returns the tables known on the publication dates of the revisions, in descending order of the date.
+// The revisions are undone from ds one by one.
+func (ds *Dataset) versions(revisions []tableRevision) ([]tableVersion, error) {
+	var errs []error
+	report := func(format string, args ...any) {
+		errs = append(errs, fmt.Errorf("holiday: invalid history: "+format, args...))
+	}
+
+	if len(revisions) == 0 {
+		report("no revisions")
+	}
+	holidays := slices.Clone(ds.holidays)
+	ret := make([]tableVersion, 0, len(revisions))
+	for i, r := range revisions {
+		if err := validateEffective(r.Published); err != nil {
+			report("revision %q: invalid published date", r.Published)
+		}
+		if i > 0 && r.Published >= revisions[i-1].Published {
+			report("revision %q: published must be in descending order", r.Published)
+		}
+		if r.EndYear < ds.startYear || r.EndYear > ds.endYear || (i > 0 && r.EndYear > revisions[i-1].EndYear) {
+			report("revision %q: invalid end_year: %d", r.Published, r.EndYear)
+		}
+		end := Date{r.EndYear, time.December, 31}.String()
+		ret = append(ret, tableVersion{
+			published: r.Published,
+			table: &Dataset{
+				holidays:  slices.DeleteFunc(slices.Clone(holidays), func(h Holiday) bool { return h.Date > end }),
+				startYear: ds.startYear,
+				endYear:   r.EndYear,
+			},
+		})
+
+		// undo the revision for the older versions.
+		for _, date := range r.Added {
+			n := len(holidays)
+			holidays = slices.DeleteFunc(holidays, func(h Holiday) bool { return h.Date == date })
+			if n == len(holidays) {
+				report("revision %q: added %q is not in the table", r.Published, date)
+			}
+		}
+		for _, h := range r.Removed {
+			if err := validateEffective(h.Date); err != nil || h.Name == "" {
+				report("revision %q: removed %q: the date and the name are required", r.Published, h.Date)
+			}
+			if contains(holidays, h.Date) {
+				report("revision %q: removed %q is in the table", r.Published, h.Date)
+			}
+			holidays = append(holidays, Holiday{Date: h.Date, Name: h.Name})
+		}
+		sort.Sort(withDate(holidays))
+		classifyHolidays(holidays)
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return ret, nil
+}
+
+// AsOf returns the calendar of the national holidays known on the day of t in JST.
+// The holidays are from the table published by the Cabinet Office as it was revised by the time,
+// and the holidays out of the table are calculated from the laws published by the time.
+// The revisions of the table are recorded in history.yaml, and the publication dates of the laws are recorded in rules.yaml.
+// For example, 2021-10-11 was スポーツの日 as of 2020-12-03,
+// but the law published on 2020-12-04 moved it to 2021-07-23.
+// It returns National() if t is on or after the latest revision of the table and the publication of the latest law.
+// The table is not used before the oldest revision in history.yaml,
+// and no holidays are known before the enactment of the law on 1948-07-20.
+func AsOf(t time.Time) *Calendar {
+	date := FromTime(t.In(jst)).String()
+	rules, latestRules := &RuleSet{}, false
+	for i, v := range versions {
+		if v.published <= date {
+			rules, latestRules = v.rules, i == 0
+			break
+		}
+	}
+	var table *Dataset
+	latestTable := false
+	for i, v := range tableVersions {
+		if v.published <= date {
+			table, latestTable = v.table, i == 0
+			break
+		}
+	}
+	if latestRules && latestTable {
+		return national
+	}
+	return &Calendar{rules: rules, table: table}
+}
@@ -0,0 +1,184 @@
+package holiday
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestAsOf(t *testing.T) {
+	tests := []struct {
+		asOf string
+		date Date
+		want string // the name of the holiday. empty means not a holiday.
+	}{
+		// Tokyo Olympics 2020 rescheduled for 2021.
+		{"2020-12-03", Date{2021, time.July, 23}, ""},
+		{"2020-12-03", Date{2021, time.October, 11}, "スポーツの日"},
+		{"2020-12-04", Date{2021, time.July, 23}, "スポーツの日"},
+		{"2020-12-04", Date{2021, time.October, 11}, ""},
+
+		// the enthronement of the emperor.
+		{"2018-12-13", Date{2019, time.April, 30}, ""},
+		{"2018-12-13", Date{2019, time.May, 1}, ""},
+		{"2018-12-13", Date{2019, time.May, 2}, ""},
+		{"2018-12-14", Date{2019, time.April, 30}, "休日"},
+		{"2018-12-14", Date{2019, time.May, 1}, "休日（祝日扱い）"},
+		{"2018-12-14", Date{2019, time.May, 2}, "休日"},
+
+		// Tokyo Olympics 2020, and スポーツの日.
+		{"2018-06-19", Date{2020, time.July, 24}, ""},
+		{"2018-06-19", Date{2020, time.October, 12}, "体育の日"},
+		{"2018-06-20", Date{2020, time.July, 24}, "スポーツの日"},
+
+		// the abdication of the emperor.
+		{"2017-06-15", Date{2019, time.December, 23}, "天皇誕生日"},
+		{"2017-06-15", Date{2020, time.February, 23}, ""},
+		{"2017-06-16", Date{2019, time.December, 23}, ""},
+
+		// 山の日
+		{"2014-05-29", Date{2016, time.August, 11}, ""},
+		{"2014-05-30", Date{2016, time.August, 11}, "山の日"},
+
+		// 昭和の日, and the holidays in lieu since 2007.
+		{"2005-05-19", Date{2007, time.April, 29}, "みどりの日"},
+		{"2005-05-19", Date{2007, time.May, 4}, "休日"},
+		{"2005-05-19", Date{2008, time.May, 6}, ""},
+		{"2005-05-20", Date{2007, time.April, 29}, "昭和の日"},
+		{"2005-05-20", Date{2008, time.May, 6}, "休日"},
+
+		// the happy monday system.
+		{"1998-10-20", Date{2000, time.January, 10}, ""},
+		{"1998-10-20", Date{2000, time.January, 15}, "成人の日"},
+		{"2001-06-21", Date{2003, time.July, 20}, "海の日"},
+		{"2001-06-21", Date{2003, time.July, 21}, "休日"},
+		{"2001-06-22", Date{2003, time.July, 20}, ""},
+		{"2001-06-22", Date{2003, time.July, 21}, "海の日"},
+
+		// 平成
+		{"1989-02-16", Date{1989, time.February, 24}, ""},
+		{"1989-02-16", Date{1989, time.April, 29}, "天皇誕生日"},
+		{"1989-02-17", Date{1989, time.February, 24}, "大喪の礼"},
+		{"1989-02-17", Date{1989, time.April, 29}, "みどりの日"},
+
+		// the enactment of the law.
+		{"1948-07-19", Date{1948, time.September, 23}, ""},
+		{"1948-07-20", Date{1948, time.September, 23}, "秋分の日"},
+	}
+	for _, tt := range tests {
+		asOf := mustParseDate(tt.asOf)
+		h, ok := AsOf(time.Date(asOf.Year(), asOf.Month(), asOf.Day(), 12, 0, 0, 0, jst)).FindHoliday(tt.date.Year, tt.date.Month, tt.date.Day)
+		if tt.want == "" {
+			if ok {
+				t.Errorf("as of %s, %s: want no holiday, got %v", tt.asOf, tt.date, h)
+			}
+			continue
+		}
+		if !ok || h.Name != tt.want {
+			t.Errorf("as of %s, %s: want %s, got %v, %t", tt.asOf, tt.date, tt.want, h, ok)
+		}
+	}
+}
+
+func TestAsOf_Latest(t *testing.T) {
+	if AsOf(time.Now()) != National() {
+		t.Error("want the national calendar")
+	}
+
+	// the latest revision of the table was published on 2026-02-02.
+	if AsOf(time.Date(2026, time.February, 1, 15, 0, 0, 0, time.UTC)) != National() {
+		t.Error("want the national calendar")
+	}
+	if AsOf(time.Date(2026, time.February, 1, 14, 59, 59, 0, time.UTC)) == National() {
+		t.Error("want the calendar before 2026-02-02")
+	}
+}
+
+func TestAsOf_Table(t *testing.T) {
+	tests := []struct {
+		asOf    string
+		endYear int // zero means the table is not used.
+	}{
+		{"2026-02-01", 2026},
+		{"2025-02-03", 2026},
+		{"2020-12-04", 2021},
+		{"2020-12-03", 2021},
+		{"2019-02-01", 2020},
+		{"2019-01-31", 0},
+	}
+	for _, tt := range tests {
+		asOf := mustParseDate(tt.asOf)
+		c := AsOf(time.Date(asOf.Year(), asOf.Month(), asOf.Day(), 12, 0, 0, 0, jst))
+		var endYear int
+		if c.table != nil {
+			endYear = c.table.EndYear()
+		}
+		if endYear != tt.endYear {
+			t.Errorf("as of %s: want the table until %d, got %d", tt.asOf, tt.endYear, endYear)
+		}
+	}
+}
+
+// The table known at a time agrees with the calculation from the laws known at the time.
+func TestTableVersions(t *testing.T) {
+	for _, v := range tableVersions {
+		published := mustParseDate(v.published)
+		rs := AsOf(published.In(jst)).rules
+		if rs == nil {
+			rs = loadRuleSet()
+		}
+		for year := v.table.StartYear(); year <= v.table.EndYear(); year++ {
+			for _, m := range verifyYear(rs, year, v.table.findHolidaysInYear(year), rs.holidaysInYear(year)) {
+				t.Errorf("as of %s: %s", v.published, m)
+			}
+		}
+	}
+}
+
+func TestDataset_Versions_Invalid(t *testing.T) {
+	tests := []struct {
+		name      string
+		revisions []tableRevision
+	}{
+		{"no revisions", nil},
+		{"invalid published date", []tableRevision{{Published: "2026-02-30", EndYear: 2027}}},
+		{"ascending published", []tableRevision{{Published: "2025-02-03", EndYear: 2026}, {Published: "2026-02-02", EndYear: 2027}}},
+		{"end_year after the table", []tableRevision{{Published: "2026-02-02", EndYear: 2028}}},
+		{"end_year before the table", []tableRevision{{Published: "2026-02-02", EndYear: 1954}}},
+		{"ascending end_year", []tableRevision{{Published: "2026-02-02", EndYear: 2026}, {Published: "2025-02-03", EndYear: 2027}}},
+		{"added not in the table", []tableRevision{{Published: "2026-02-02", EndYear: 2027, Added: []string{"2027-01-02"}}}},
+		{"removed in the table", []tableRevision{{Published: "2026-02-02", EndYear: 2027, Removed: []tableHoliday{{Date: "2027-01-01", Name: "元日"}}}}},
+		{"removed without name", []tableRevision{{Published: "2026-02-02", EndYear: 2027, Removed: []tableHoliday{{Date: "2027-01-02"}}}}},
+	}
+	for _, tt := range tests {
+		if _, err := defaultDataset.versions(tt.revisions); err == nil {
+			t.Errorf("%s: want error, got nil", tt.name)
+		}
+	}
+}
+
+func TestAsOf_BeforeEnactment(t *testing.T) {
+	c := AsOf(time.Date(1948, time.July, 19, 0, 0, 0, 0, jst))
+	if got := c.FindHolidaysInRange(Date{1948, time.January, 1}, Date{2030, time.December, 31}); len(got) != 0 {
+		t.Errorf("want no holidays, got %v", got)
+	}
+	if h, ok := c.PreviousHoliday(Date{2030, time.January, 1}); ok {
+		t.Errorf("want no holidays, got %v", h)
+	}
+}
+
+// The laws are not retroactive, so the holidays before a revision were known before the revision.
+func TestAsOf_Past(t *testing.T) {
+	from := Date{1948, time.January, 1}
+	for _, v := range versions {
+		published := mustParseDate(v.published)
+		to := FromTime(published.AddDate(0, 0, -1))
+		c := AsOf(published.AddDate(0, 0, -1).In(jst))
+
+		want := FindHolidaysInRange(from, to)
+		got := c.FindHolidaysInRange(from, to)
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("as of %s: the holidays until %s are different", to, to)
+		}
+	}
+}
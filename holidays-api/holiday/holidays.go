@@ -1,53 +1,19 @@
 package holiday
 
 import (
-	"cmp"
 	"fmt"
 	"math"
+	"slices"
 	"sort"
 	"strings"
 	"time"
 )
 
-// Date represents a date.
-type Date struct {
-	Year  int
-	Month time.Month
-	Day   int
-}
-
-func (a Date) cmp(b Date) int {
-	if a.Year != b.Year {
-		return cmp.Compare(a.Year, b.Year)
-	}
-	if a.Month != b.Month {
-		return cmp.Compare(a.Month, b.Month)
-	}
-	return cmp.Compare(a.Day, b.Day)
-}
-
-func (d Date) String() string {
-	return fmt.Sprintf("%04d-%02d-%02d", d.Year, int(d.Month), d.Day)
-}
-
-// firstDay returns the first day of the month.
-func (d Date) firstDay() Date {
-	return Date{d.Year, d.Month, 1}
-}
-
-// nextMonth returns the first day of the next month.
-func (d Date) nextMonth() Date {
-	if d.Month == time.December {
-		return Date{d.Year + 1, time.January, 1}
-	}
-	return Date{d.Year, d.Month + 1, 1}
-}
-
 // FindHoliday returns whether the specific day is a holiday.
 func FindHoliday(year int, month time.Month, day int) (Holiday, bool) {
-	if holidaysStartYear <= year && year <= holidaysEndYear {
+	if ds := loadDataset(); ds.contains(year) {
 		// return from pre-calculated holidays
-		return findHoliday(year, month, day)
+		return ds.findHoliday(year, month, day)
 	}
 
 	// calculate holidays based on the law
@@ -63,9 +29,9 @@ func FindHoliday(year int, month time.Month, day int) (Holiday, bool) {
 
 // FindHolidaysInMonth returns holidays in the month.
 func FindHolidaysInMonth(year int, month time.Month) []Holiday {
-	if holidaysStartYear <= year && year <= holidaysEndYear {
+	if ds := loadDataset(); ds.contains(year) {
 		// return from pre-calculated holidays
-		return findHolidaysInMonth(year, month)
+		return ds.findHolidaysInMonth(year, month)
 	}
 
 	// calculate holidays based on the law
@@ -74,26 +40,65 @@ func FindHolidaysInMonth(year int, month time.Month) []Holiday {
 
 // FindHolidaysInYear returns holidays in the year.
 func FindHolidaysInYear(year int) []Holiday {
-	if holidaysStartYear <= year && year <= holidaysEndYear {
+	if ds := loadDataset(); ds.contains(year) {
 		// return from pre-calculated holidays
-		return findHolidaysInYear(year)
+		return ds.findHolidaysInYear(year)
 	}
 
 	// calculate holidays based on the law
 	return calcHolidaysInYear(year)
 }
 
+// FindHolidaysInRange returns holidays between from and to, inclusive.
 func FindHolidaysInRange(from, to Date) []Holiday {
-	if from.cmp(to) > 0 {
-		from, to = to, from
-	}
-	if holidaysStartYear <= from.Year && to.Year <= holidaysEndYear {
-		// return from pre-calculated holidays
-		return findHolidaysInRange(from, to)
+	return slices.Collect(All(from, to))
+}
+
+// FindHolidaysByID returns the holidays with the identifier between from and to, inclusive.
+// The identifier is stable even if the holiday is renamed or moved,
+// so e.g. "sports-day" finds both 体育の日 and スポーツの日.
+func FindHolidaysByID(id string, from, to Date) []Holiday {
+	return national.FindHolidaysByID(id, from, to)
+}
+
+// NextHoliday returns the first holiday after d.
+// It returns false if there is no holiday after d.
+func NextHoliday(d Date) (Holiday, bool) {
+	for h := range From(d.AddDays(1)) {
+		return h, true
 	}
+	return Holiday{}, false
+}
 
-	// calculate holidays based on the law
-	return calcHolidaysInRange(from, to)
+// PreviousHoliday returns the last holiday before d.
+// It returns false if there is no holiday before d.
+func PreviousHoliday(d Date) (Holiday, bool) {
+	date := d.String()
+	start := calcHolidaysStartYear()
+	ds := loadDataset()
+
+	for m := d.firstDay(); m.Year >= start; m = m.prevMonth() {
+		if ds.contains(m.Year) {
+			// search from pre-calculated holidays
+			idx := sort.Search(len(ds.holidays), func(i int) bool {
+				return ds.holidays[i].Date >= date
+			})
+			if idx > 0 {
+				return ds.holidays[idx-1], true
+			}
+			m = Date{ds.startYear, time.January, 1}
+			continue
+		}
+
+		// calculate holidays based on the law
+		holidays := calcHolidaysInMonth(m.Year, m.Month)
+		for i := len(holidays) - 1; i >= 0; i-- {
+			if holidays[i].Date < date {
+				return holidays[i], true
+			}
+		}
+	}
+	return Holiday{}, false
 }
 
 const dateLayout = "2006-01-02"
@@ -109,6 +114,16 @@ func mustParseDate(date string) time.Time {
 type Holiday struct {
 	Date string
 	Name string
+	Kind Kind
+
+	// ID is the identifier of the holiday. e.g. "sports-day"
+	// It is stable even if the holiday is renamed or moved.
+	// It is empty for the holidays added by overlays.
+	ID string
+
+	// Kana is the reading (よみ) of Name in hiragana. e.g. "けんこくきねんのひ"
+	// It is empty for the holidays added by overlays.
+	Kana string
 }
 
 type withDate []Holiday
@@ -118,81 +133,78 @@ func (s withDate) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
 func (s withDate) Less(i, j int) bool { return s[i].Date < s[j].Date }
 
 // findHoliday returns whether the specific day is a holiday.
-func findHoliday(year int, month time.Month, day int) (Holiday, bool) {
+func (ds *Dataset) findHoliday(year int, month time.Month, day int) (Holiday, bool) {
 	date := fmt.Sprintf("%04d-%02d-%02d", year, int(month), day)
-	idx := sort.Search(len(holidays), func(i int) bool {
-		return holidays[i].Date >= date
+	idx := sort.Search(len(ds.holidays), func(i int) bool {
+		return ds.holidays[i].Date >= date
 	})
 
-	if idx < len(holidays) && holidays[idx].Date == date {
-		return holidays[idx], true
+	if idx < len(ds.holidays) && ds.holidays[idx].Date == date {
+		return ds.holidays[idx], true
 	}
 	return Holiday{}, false
 }
 
 // findHolidaysInMonth returns holidays in the specific month.
-func findHolidaysInMonth(year int, month time.Month) []Holiday {
+func (ds *Dataset) findHolidaysInMonth(year int, month time.Month) []Holiday {
 	startDate := Date{year, month, 1}
 	endDate := Date{year, month, 31}
-	return findHolidaysInRange(startDate, endDate)
+	return ds.findHolidaysInRange(startDate, endDate)
 }
 
 // findHolidaysInYear returns holidays in the specific year.
-func findHolidaysInYear(year int) []Holiday {
+func (ds *Dataset) findHolidaysInYear(year int) []Holiday {
 	startDate := Date{year, time.January, 1}
 	endDate := Date{year, time.December, 31}
-	return findHolidaysInRange(startDate, endDate)
+	return ds.findHolidaysInRange(startDate, endDate)
 }
 
 // findHolidaysInRange returns holidays in the specific range.
-func findHolidaysInRange(from, to Date) []Holiday {
+func (ds *Dataset) findHolidaysInRange(from, to Date) []Holiday {
 	startDate := from.String()
 	endDate := to.String()
 
-	start := sort.Search(len(holidays), func(i int) bool {
-		return holidays[i].Date >= startDate
+	start := sort.Search(len(ds.holidays), func(i int) bool {
+		return ds.holidays[i].Date >= startDate
 	})
-	end := sort.Search(len(holidays), func(i int) bool {
-		return holidays[i].Date >= endDate
+	end := sort.Search(len(ds.holidays), func(i int) bool {
+		return ds.holidays[i].Date >= endDate
 	})
-	if end < len(holidays) && holidays[end].Date == endDate {
+	if end < len(ds.holidays) && ds.holidays[end].Date == endDate {
 		end++
 	}
-	return holidays[start:end]
+	return ds.holidays[start:end]
 }
 
-type annuallyHolidaysRule struct {
-	// BeginYear is a year that the law is enforced
-	BeginYear int
+// Law is a citation of a law.
+type Law struct {
+	// Number is the number of the law. e.g. 昭和二十三年法律第百七十八号
+	Number string `yaml:"number"`
 
-	// StaticHolydays are holydays that are on the same date every year
-	StaticHolydays []staticHolyday
+	// Title is the title of the law. e.g. 国民の祝日に関する法律
+	Title string `yaml:"title"`
 
-	// StaticHolydays are holydays that are on the same weekday in the month.
-	WeekdayHolydays []weekdayHolyday
+	// URL is the URL of the text of the law.
+	URL string `yaml:"url"`
 }
 
-type staticHolyday struct {
-	Date string // MM-DD
-	Name string
+// calcHolidaysStartYear returns the first year that the law defines holidays.
+func calcHolidaysStartYear() int {
+	return loadRuleSet().startYear()
 }
 
-type weekdayHolyday struct {
-	Month   time.Month
-	Weekday time.Weekday
-	Index   int
-	Name    string
+func calcHolidaysInMonthWithoutInLieu(year int, month time.Month) []Holiday {
+	return loadRuleSet().holidaysInMonthWithoutInLieu(year, month)
 }
 
-func calcHolidaysInMonthWithoutInLieu(year int, month time.Month) []Holiday {
+func calcHolidaysInMonth(year int, month time.Month) []Holiday {
+	return loadRuleSet().holidaysInMonth(year, month)
+}
+
+// holidaysInMonthWithoutInLieu calculates the national holidays in the month based on rs.
+func (rs *RuleSet) holidaysInMonthWithoutInLieu(year int, month time.Month) []Holiday {
 	// search the rule of this year
-	var rule *annuallyHolidaysRule
-	for i := range annuallyHolidaysRules {
-		if year >= annuallyHolidaysRules[i].BeginYear {
-			rule = &annuallyHolidaysRules[i]
-			break
-		}
-	}
+	rule := rs.ruleOf(year)
 	if rule == nil {
 		return nil
 	}
@@ -205,46 +217,53 @@ func calcHolidaysInMonthWithoutInLieu(year int, month time.Month) []Holiday {
 			holydays = append(holydays, Holiday{
 				Date: yearPrefix + d.Date,
 				Name: d.Name,
+				ID:   d.ID,
+				Kana: d.Kana,
 			})
 		}
 	}
 
 	weekdayOfFirstDay := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC).Weekday()
-	_ = weekdayOfFirstDay
 	for _, d := range rule.WeekdayHolydays {
 		if d.Month == month {
 			day := int(d.Weekday - weekdayOfFirstDay)
 			if day < 0 {
 				day += 7
 			}
-			day += d.Index*7 + 1
+			day += (d.Nth-1)*7 + 1
 			holydays = append(holydays, Holiday{
 				Date: fmt.Sprintf("%04d-%02d-%02d", year, int(month), day),
 				Name: d.Name,
+				ID:   d.ID,
+				Kana: d.Kana,
 			})
 		}
 	}
 
-	// Vernal Equinox Day
-	if month == time.March {
-		holydays = append(holydays, Holiday{
-			Date: fmt.Sprintf("%04d-%02d-%02d", year, int(month), vernalEquinoxDay(year)),
-			Name: "春分の日",
-		})
-	}
-
-	// Autumnal Equinox Day
-	if month == time.September {
+	for _, d := range rule.EquinoxHolydays {
+		var day int
+		switch {
+		case d.Equinox == equinoxVernal && month == time.March:
+			// Vernal Equinox Day
+			day = vernalEquinoxDay(year)
+		case d.Equinox == equinoxAutumnal && month == time.September:
+			// Autumnal Equinox Day
+			day = autumnalEquinoxDay(year)
+		default:
+			continue
+		}
 		holydays = append(holydays, Holiday{
-			Date: fmt.Sprintf("%04d-%02d-%02d", year, int(month), autumnalEquinoxDay(year)),
-			Name: "秋分の日",
+			Date: fmt.Sprintf("%04d-%02d-%02d", year, int(month), day),
+			Name: d.Name,
+			ID:   d.ID,
+			Kana: d.Kana,
 		})
 	}
 
 	yearMonthPrefix := yearPrefix + monthPrefix
-	for _, d := range specialHolidays {
+	for _, d := range rs.special {
 		if strings.HasPrefix(d.Date, yearMonthPrefix) {
-			holydays = append(holydays, d)
+			holydays = append(holydays, d.holiday())
 		}
 	}
 
@@ -252,13 +271,13 @@ func calcHolidaysInMonthWithoutInLieu(year int, month time.Month) []Holiday {
 	return holydays
 }
 
-func calcHolidaysInMonth(year int, month time.Month) []Holiday {
-	holidays := calcHolidaysInMonthWithoutInLieu(year, month)
+// holidaysInMonth calculates the holidays in the month, including the holidays in lieu, based on rs.
+func (rs *RuleSet) holidaysInMonth(year int, month time.Month) []Holiday {
+	holidays := rs.holidaysInMonthWithoutInLieu(year, month)
 
-	// 昭和六十年法律第百三号
-	// 国民の祝日に関する法律の一部を改正する法律
-	// 衆議院制定法律: https://www.shugiin.go.jp/internet/itdb_housei.nsf/html/houritsu/10319851227103.htm
-	if year >= 1986 {
+	// the citizens' holidays (国民の休日)
+	// See in_lieu.citizens in rules.yaml for the laws.
+	if rs.citizensPolicyOf(fmt.Sprintf("%04d-%02d-01", year, int(month))) != nil {
 		var extraHolidays []Holiday
 		for i := 0; i < len(holidays)-1; i++ {
 			holidayA := mustParseDate(holidays[i].Date)
@@ -268,43 +287,60 @@ func calcHolidaysInMonth(year int, month time.Month) []Holiday {
 			// > ３　その前日及び翌日が「国民の祝日」である日（日曜日にあたる日及び前項に規定する休日にあたる日を除く。）は、休日とする。
 			if holidayB.Sub(holidayA) == 2*24*time.Hour {
 				d := holidayA.Add(24 * time.Hour)
-				if d.Weekday() != time.Sunday {
+
+				// If holidayA is Sunday, d is a substitute holiday.
+				// It is added in the following step.
+				if d.Weekday() != time.Sunday && holidayA.Weekday() != time.Sunday {
 					extraHolidays = append(extraHolidays, Holiday{
 						Date: d.Format(dateLayout),
 						Name: "休日",
+						Kind: KindCitizens,
+						ID:   "citizens-holiday",
+						Kana: "きゅうじつ",
 					})
 				}
 			}
 		}
 
-		// Handle edge cases that span months
+		// Handle edge cases that span months.
+		// The day in between belongs to this month only if it is the first or the last day of the month.
 		if len(holidays) > 0 {
 			firstHolidayInMonth := mustParseDate(holidays[0].Date)
-			beforeTwoDays := firstHolidayInMonth.Add(-2 * 24 * time.Hour)
-			if firstHolidayInMonth.Month() != beforeTwoDays.Month() && firstHolidayInMonth.Weekday() != time.Monday {
+			if firstHolidayInMonth.Day() == 2 {
 				// the first day in the month might be a holiday
-				previousHolidays := calcHolidaysInMonthWithoutInLieu(
+				d := firstHolidayInMonth.Add(-24 * time.Hour)
+				beforeTwoDays := d.Add(-24 * time.Hour)
+				previousHolidays := rs.holidaysInMonthWithoutInLieu(
 					beforeTwoDays.Year(), beforeTwoDays.Month(),
 				)
-				if len(previousHolidays) > 0 && previousHolidays[len(previousHolidays)-1].Date == beforeTwoDays.Format(dateLayout) {
+				if len(previousHolidays) > 0 && previousHolidays[len(previousHolidays)-1].Date == beforeTwoDays.Format(dateLayout) &&
+					d.Weekday() != time.Sunday && beforeTwoDays.Weekday() != time.Sunday {
 					extraHolidays = append(extraHolidays, Holiday{
-						Date: firstHolidayInMonth.Add(-24 * time.Hour).Format(dateLayout),
+						Date: d.Format(dateLayout),
 						Name: "休日",
+						Kind: KindCitizens,
+						ID:   "citizens-holiday",
+						Kana: "きゅうじつ",
 					})
 				}
 			}
 
 			lastHolidayInMonth := mustParseDate(holidays[len(holidays)-1].Date)
-			afterTwoDays := lastHolidayInMonth.Add(2 * 24 * time.Hour)
-			if lastHolidayInMonth.Month() != afterTwoDays.Month() && lastHolidayInMonth.Weekday() != time.Monday {
+			d := lastHolidayInMonth.Add(24 * time.Hour)
+			afterTwoDays := d.Add(24 * time.Hour)
+			if d.Month() == lastHolidayInMonth.Month() && afterTwoDays.Month() != d.Month() {
 				// the last day in the month might be a holiday
-				nextHolidays := calcHolidaysInMonthWithoutInLieu(
+				nextHolidays := rs.holidaysInMonthWithoutInLieu(
 					afterTwoDays.Year(), afterTwoDays.Month(),
 				)
-				if len(nextHolidays) > 0 && nextHolidays[0].Date == afterTwoDays.Format(dateLayout) {
+				if len(nextHolidays) > 0 && nextHolidays[0].Date == afterTwoDays.Format(dateLayout) &&
+					d.Weekday() != time.Sunday && lastHolidayInMonth.Weekday() != time.Sunday {
 					extraHolidays = append(extraHolidays, Holiday{
-						Date: lastHolidayInMonth.Add(24 * time.Hour).Format(dateLayout),
+						Date: d.Format(dateLayout),
 						Name: "休日",
+						Kind: KindCitizens,
+						ID:   "citizens-holiday",
+						Kana: "きゅうじつ",
 					})
 				}
 			}
@@ -314,67 +350,44 @@ func calcHolidaysInMonth(year int, month time.Month) []Holiday {
 		sort.Sort(withDate(holidays))
 	}
 
-	// 昭和四十八年法律第十号
-	// 国民の祝日に関する法律の一部を改正する法律
-	// 衆議院制定法律: https://www.shugiin.go.jp/internet/itdb_housei.nsf/html/houritsu/07119730412010.htm
-	//
-	// > 第三条に次の一項を加える。
-	// > ２　「国民の祝日」が日曜日にあたるときは、その翌日を休日とする。
-	if 1973 <= year && year < 2007 {
-		var holidaysInLieu []Holiday
-		for _, holiday := range holidays {
-
-			// This law was enacted on April 12, 1973,
-			// so it did not apply to holidays before that date.
-			if holiday.Date <= "1973-04-12" {
-				continue
-			}
-
-			d, err := time.Parse(dateLayout, holiday.Date)
-			if err != nil {
-				panic(err)
-			}
-			if d.Weekday() != time.Sunday {
-				continue
-			}
-			d = d.Add(24 * time.Hour)
-			if !contains(holidays, d.Format(dateLayout)) {
-				holidaysInLieu = append(holidaysInLieu, Holiday{
-					Date: d.Format(dateLayout),
-					Name: "休日",
-				})
-			}
+	// the substitute holidays (振替休日)
+	// See in_lieu.substitute in rules.yaml for the laws.
+	var holidaysInLieu []Holiday
+	for _, holiday := range holidays {
+		policy := rs.substitutePolicyOf(holiday.Date)
+		if policy == nil {
+			continue
 		}
-		holidays = append(holidays, holidaysInLieu...)
-		sort.Sort(withDate(holidays))
-	}
 
-	// 平成十七年法律第四十三号
-	// 国民の祝日に関する法律の一部を改正する法律
-	// 衆議院制定法律: https://www.shugiin.go.jp/internet/itdb_housei.nsf/html/housei/16220050520043.htm
-	// 官報: https://kanpou.npb.go.jp/old/20050520/20050520g00109/20050520g001090005f.html
-	//
-	// > 第三条第二項中「あたるときは、その翌日」を「当たるときは、その日後においてその日に最も近い「国民の祝日」でない日」に改め、
-	// > 同条第三項中「日曜日にあたる日及び前項に規定する休日にあたる日を除く。」を「「国民の祝日」でない日に限る。」に改める。
-	if year >= 2007 {
-		var holidaysInLieu []Holiday
-		for _, holiday := range holidays {
-			d, err := time.Parse(dateLayout, holiday.Date)
-			if err != nil {
-				panic(err)
-			}
-			if d.Weekday() != time.Sunday {
+		d, err := time.Parse(dateLayout, holiday.Date)
+		if err != nil {
+			panic(err)
+		}
+		if d.Weekday() != time.Sunday {
+			continue
+		}
+		d = d.Add(24 * time.Hour)
+		switch policy.Rule {
+		case substituteNextDay:
+			// > ２　「国民の祝日」が日曜日にあたるときは、その翌日を休日とする。
+			if contains(holidays, d.Format(dateLayout)) {
 				continue
 			}
-			d = d.Add(24 * time.Hour)
+		case substituteNearestNonHoliday:
+			// > ２　「国民の祝日」が日曜日に当たるときは、その日後においてその日に最も近い「国民の祝日」でない日を休日とする。
 			for contains(holidays, d.Format(dateLayout)) {
 				d = d.Add(24 * time.Hour)
 			}
-			holidaysInLieu = append(holidaysInLieu, Holiday{
-				Date: d.Format(dateLayout),
-				Name: "休日",
-			})
 		}
+		holidaysInLieu = append(holidaysInLieu, Holiday{
+			Date: d.Format(dateLayout),
+			Name: "休日",
+			Kind: KindSubstitute,
+			ID:   "substitute-holiday",
+			Kana: "きゅうじつ",
+		})
+	}
+	if len(holidaysInLieu) > 0 {
 		holidays = append(holidays, holidaysInLieu...)
 		sort.Sort(withDate(holidays))
 	}
@@ -392,31 +405,14 @@ func contains(holidays []Holiday, date string) bool {
 }
 
 func calcHolidaysInYear(year int) []Holiday {
-	var result []Holiday
-	for month := time.January; month <= time.December; month++ {
-		holidays := calcHolidaysInMonth(year, month)
-		result = append(result, holidays...)
-	}
-	return result
+	return loadRuleSet().holidaysInYear(year)
 }
 
-func calcHolidaysInRange(from, to Date) []Holiday {
-	if from.cmp(to) > 0 {
-		from, to = to, from
-	}
-
-	firstDay := to.firstDay()
-
-	startDate := from.String()
-	endDate := to.String()
+func (rs *RuleSet) holidaysInYear(year int) []Holiday {
 	var result []Holiday
-	for d := from.firstDay(); d.cmp(firstDay) <= 0; d = d.nextMonth() {
-		holidays := calcHolidaysInMonth(d.Year, d.Month)
-		for _, h := range holidays {
-			if startDate <= h.Date && h.Date <= endDate {
-				result = append(result, h)
-			}
-		}
+	for month := time.January; month <= time.December; month++ {
+		holidays := rs.holidaysInMonth(year, month)
+		result = append(result, holidays...)
 	}
 	return result
 }
@@ -493,23 +489,9 @@ func init() {
 }
 
 func vernalEquinoxDay(year int) int {
-	for i := 10; i <= 31; i++ {
-		t := time.Date(year, time.March, i, 0, 0, 0, 0, jst)
-		l := sunLongitude(time2JulianYear(t))
-		if l < 180 {
-			return i - 1
-		}
-	}
-	return 0
+	return SolarTermShunbun.In(year).Day()
 }
 
 func autumnalEquinoxDay(year int) int {
-	for i := 10; i <= 30; i++ {
-		t := time.Date(year, time.September, i, 0, 0, 0, 0, jst)
-		l := sunLongitude(time2JulianYear(t))
-		if l >= 180 {
-			return i - 1
-		}
-	}
-	return 0
+	return SolarTermShubun.In(year).Day()
 }
@@ -1,6 +1,7 @@
 package holiday
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
@@ -8,7 +9,7 @@ import (
 )
 
 func TestFindHoliday(t *testing.T) {
-	h, ok := findHoliday(2000, time.January, 1)
+	h, ok := defaultDataset.findHoliday(2000, time.January, 1)
 	if !ok {
 		t.Error("want true, but got false")
 	}
@@ -18,15 +19,19 @@ func TestFindHoliday(t *testing.T) {
 }
 
 func TestFindHolidaysInMonth(t *testing.T) {
-	got := findHolidaysInMonth(2000, time.January)
+	got := defaultDataset.findHolidaysInMonth(2000, time.January)
 	want := []Holiday{
 		{
 			Date: "2000-01-01",
 			Name: "元日",
+			ID:   "new-years-day",
+			Kana: "がんじつ",
 		},
 		{
 			Date: "2000-01-10",
 			Name: "成人の日",
+			ID:   "coming-of-age-day",
+			Kana: "せいじんのひ",
 		},
 	}
 	if diff := cmp.Diff(want, got); diff != "" {
@@ -35,67 +40,98 @@ func TestFindHolidaysInMonth(t *testing.T) {
 }
 
 func TestFindHolidaysInYear(t *testing.T) {
-	got := findHolidaysInYear(2000)
+	got := defaultDataset.findHolidaysInYear(2000)
 	want := []Holiday{
 		{
 			Date: "2000-01-01",
 			Name: "元日",
+			ID:   "new-years-day",
+			Kana: "がんじつ",
 		},
 		{
 			Date: "2000-01-10",
 			Name: "成人の日",
+			ID:   "coming-of-age-day",
+			Kana: "せいじんのひ",
 		},
 		{
 			Date: "2000-02-11",
 			Name: "建国記念の日",
+			ID:   "national-foundation-day",
+			Kana: "けんこくきねんのひ",
 		},
 		{
 			Date: "2000-03-20",
 			Name: "春分の日",
+			ID:   "vernal-equinox-day",
+			Kana: "しゅんぶんのひ",
 		},
 		{
 			Date: "2000-04-29",
 			Name: "みどりの日",
+			ID:   "greenery-day",
+			Kana: "みどりのひ",
 		},
 		{
 			Date: "2000-05-03",
 			Name: "憲法記念日",
+			ID:   "constitution-memorial-day",
+			Kana: "けんぽうきねんび",
 		},
 		{
 			Date: "2000-05-04",
 			Name: "休日",
+			Kind: KindCitizens,
+			ID:   "citizens-holiday",
+			Kana: "きゅうじつ",
 		},
 		{
 			Date: "2000-05-05",
 			Name: "こどもの日",
+			ID:   "childrens-day",
+			Kana: "こどものひ",
 		},
 		{
 			Date: "2000-07-20",
 			Name: "海の日",
+			ID:   "marine-day",
+			Kana: "うみのひ",
 		},
 		{
 			Date: "2000-09-15",
 			Name: "敬老の日",
+			ID:   "respect-for-the-aged-day",
+			Kana: "けいろうのひ",
 		},
 		{
 			Date: "2000-09-23",
 			Name: "秋分の日",
+			ID:   "autumnal-equinox-day",
+			Kana: "しゅうぶんのひ",
 		},
 		{
 			Date: "2000-10-09",
 			Name: "体育の日",
+			ID:   "sports-day",
+			Kana: "たいいくのひ",
 		},
 		{
 			Date: "2000-11-03",
 			Name: "文化の日",
+			ID:   "culture-day",
+			Kana: "ぶんかのひ",
 		},
 		{
 			Date: "2000-11-23",
 			Name: "勤労感謝の日",
+			ID:   "labor-thanksgiving-day",
+			Kana: "きんろうかんしゃのひ",
 		},
 		{
 			Date: "2000-12-23",
 			Name: "天皇誕生日",
+			ID:   "emperors-birthday",
+			Kana: "てんのうたんじょうび",
 		},
 	}
 	if diff := cmp.Diff(want, got); diff != "" {
@@ -104,14 +140,19 @@ func TestFindHolidaysInYear(t *testing.T) {
 }
 
 func TestCalcHolidaysInRange(t *testing.T) {
+	// the calendar calculated from the rules without the pre-calculated holidays.
+	calcCalendar := &Calendar{rules: defaultRuleSet}
+
 	t.Run("2000-01-01 to 2000-01-09", func(t *testing.T) {
 		from := Date{Year: 2000, Month: time.January, Day: 1}
 		to := Date{Year: 2000, Month: time.January, Day: 9}
-		got := calcHolidaysInRange(from, to)
+		got := calcCalendar.FindHolidaysInRange(from, to)
 		want := []Holiday{
 			{
 				Date: "2000-01-01",
 				Name: "元日",
+				ID:   "new-years-day",
+				Kana: "がんじつ",
 			},
 		}
 
@@ -123,15 +164,19 @@ func TestCalcHolidaysInRange(t *testing.T) {
 	t.Run("2000-01-01 to 2000-01-10", func(t *testing.T) {
 		from := Date{Year: 2000, Month: time.January, Day: 1}
 		to := Date{Year: 2000, Month: time.January, Day: 10}
-		got := calcHolidaysInRange(from, to)
+		got := calcCalendar.FindHolidaysInRange(from, to)
 		want := []Holiday{
 			{
 				Date: "2000-01-01",
 				Name: "元日",
+				ID:   "new-years-day",
+				Kana: "がんじつ",
 			},
 			{
 				Date: "2000-01-10",
 				Name: "成人の日",
+				ID:   "coming-of-age-day",
+				Kana: "せいじんのひ",
 			},
 		}
 
@@ -143,11 +188,13 @@ func TestCalcHolidaysInRange(t *testing.T) {
 	t.Run("2000-01-02 to 2000-01-10", func(t *testing.T) {
 		from := Date{Year: 2000, Month: time.January, Day: 2}
 		to := Date{Year: 2000, Month: time.January, Day: 10}
-		got := calcHolidaysInRange(from, to)
+		got := calcCalendar.FindHolidaysInRange(from, to)
 		want := []Holiday{
 			{
 				Date: "2000-01-10",
 				Name: "成人の日",
+				ID:   "coming-of-age-day",
+				Kana: "せいじんのひ",
 			},
 		}
 
@@ -159,19 +206,25 @@ func TestCalcHolidaysInRange(t *testing.T) {
 	t.Run("2000-12-01 to 2001-01-31", func(t *testing.T) {
 		from := Date{Year: 2000, Month: time.December, Day: 1}
 		to := Date{Year: 2001, Month: time.January, Day: 31}
-		got := calcHolidaysInRange(from, to)
+		got := calcCalendar.FindHolidaysInRange(from, to)
 		want := []Holiday{
 			{
 				Date: "2000-12-23",
 				Name: "天皇誕生日",
+				ID:   "emperors-birthday",
+				Kana: "てんのうたんじょうび",
 			},
 			{
 				Date: "2001-01-01",
 				Name: "元日",
+				ID:   "new-years-day",
+				Kana: "がんじつ",
 			},
 			{
 				Date: "2001-01-08",
 				Name: "成人の日",
+				ID:   "coming-of-age-day",
+				Kana: "せいじんのひ",
 			},
 		}
 
@@ -187,10 +240,14 @@ func TestCalcHolidaysInMonthWithoutInLieu(t *testing.T) {
 		{
 			Date: "2022-01-01",
 			Name: "元日",
+			ID:   "new-years-day",
+			Kana: "がんじつ",
 		},
 		{
 			Date: "2022-01-10",
 			Name: "成人の日",
+			ID:   "coming-of-age-day",
+			Kana: "せいじんのひ",
 		},
 	}
 	if diff := cmp.Diff(want, got); diff != "" {
@@ -200,10 +257,149 @@ func TestCalcHolidaysInMonthWithoutInLieu(t *testing.T) {
 
 func TestCalcHolidaysInYear(t *testing.T) {
 	for year := holidaysStartYear; year <= holidaysEndYear; year++ {
-		want := findHolidaysInYear(year)
+		want := defaultDataset.findHolidaysInYear(year)
 		got := calcHolidaysInYear(year)
 		if diff := cmp.Diff(want, got); diff != "" {
 			t.Errorf("holidays in %d mismatch: (-want/+got):\n%s", year, diff)
 		}
 	}
 }
+
+func TestFindHolidaysByID(t *testing.T) {
+	tests := []struct {
+		id       string
+		from, to Date
+		want     []Holiday
+	}{
+		{
+			// 体育の日 was renamed to スポーツの日 in 2020.
+			id:   "sports-day",
+			from: Date{2018, time.January, 1},
+			to:   Date{2021, time.December, 31},
+			want: []Holiday{
+				{Date: "2018-10-08", Name: "体育の日", ID: "sports-day", Kana: "たいいくのひ"},
+				{Date: "2019-10-14", Name: "体育の日（スポーツの日）", ID: "sports-day", Kana: "たいいくのひ（すぽーつのひ）"},
+				{Date: "2020-07-24", Name: "スポーツの日", ID: "sports-day", Kana: "すぽーつのひ"},
+				{Date: "2021-07-23", Name: "スポーツの日", ID: "sports-day", Kana: "すぽーつのひ"},
+			},
+		},
+		{
+			// 天皇誕生日 moved from 04-29 to 12-23 in 1989.
+			id:   "emperors-birthday",
+			from: Date{1988, time.January, 1},
+			to:   Date{1990, time.December, 31},
+			want: []Holiday{
+				{Date: "1988-04-29", Name: "天皇誕生日", ID: "emperors-birthday", Kana: "てんのうたんじょうび"},
+				{Date: "1989-12-23", Name: "天皇誕生日", ID: "emperors-birthday", Kana: "てんのうたんじょうび"},
+				{Date: "1990-12-23", Name: "天皇誕生日", ID: "emperors-birthday", Kana: "てんのうたんじょうび"},
+			},
+		},
+		{
+			// 天皇誕生日 moved to 02-23 in 2020, and there was none in 2019.
+			id:   "emperors-birthday",
+			from: Date{2018, time.January, 1},
+			to:   Date{2020, time.December, 31},
+			want: []Holiday{
+				{Date: "2018-12-23", Name: "天皇誕生日", ID: "emperors-birthday", Kana: "てんのうたんじょうび"},
+				{Date: "2020-02-23", Name: "天皇誕生日", ID: "emperors-birthday", Kana: "てんのうたんじょうび"},
+			},
+		},
+		{
+			id:   "unknown",
+			from: Date{2000, time.January, 1},
+			to:   Date{2000, time.December, 31},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		got := FindHolidaysByID(tt.id, tt.from, tt.to)
+		if diff := cmp.Diff(tt.want, got); diff != "" {
+			t.Errorf("FindHolidaysByID(%q, %s, %s) mismatch: (-want/+got)\n%s", tt.id, tt.from, tt.to, diff)
+		}
+	}
+}
+
+func TestNextHoliday(t *testing.T) {
+	tests := []struct {
+		date Date
+		want Holiday
+		ok   bool
+	}{
+		{
+			date: Date{2000, time.January, 1},
+			want: Holiday{Date: "2000-01-10", Name: "成人の日", ID: "coming-of-age-day", Kana: "せいじんのひ"},
+			ok:   true,
+		},
+		{
+			date: Date{2000, time.December, 24},
+			want: Holiday{Date: "2001-01-01", Name: "元日", ID: "new-years-day", Kana: "がんじつ"},
+			ok:   true,
+		},
+		{
+			// across the end of pre-calculated holidays
+			date: Date{holidaysEndYear, time.December, 31},
+			want: Holiday{Date: fmt.Sprintf("%04d-01-01", holidaysEndYear+1), Name: "元日", ID: "new-years-day", Kana: "がんじつ"},
+			ok:   true,
+		},
+		{
+			// across the start of pre-calculated holidays
+			date: Date{holidaysStartYear - 1, time.December, 24},
+			want: Holiday{Date: fmt.Sprintf("%04d-01-01", holidaysStartYear), Name: "元日", ID: "new-years-day", Kana: "がんじつ"},
+			ok:   true,
+		},
+	}
+	for _, tt := range tests {
+		got, ok := NextHoliday(tt.date)
+		if ok != tt.ok {
+			t.Errorf("NextHoliday(%s): want %t, got %t", tt.date, tt.ok, ok)
+		}
+		if diff := cmp.Diff(tt.want, got); diff != "" {
+			t.Errorf("NextHoliday(%s) mismatch: (-want/+got)\n%s", tt.date, diff)
+		}
+	}
+}
+
+func TestPreviousHoliday(t *testing.T) {
+	tests := []struct {
+		date Date
+		want Holiday
+		ok   bool
+	}{
+		{
+			date: Date{2000, time.January, 10},
+			want: Holiday{Date: "2000-01-01", Name: "元日", ID: "new-years-day", Kana: "がんじつ"},
+			ok:   true,
+		},
+		{
+			date: Date{2001, time.January, 1},
+			want: Holiday{Date: "2000-12-23", Name: "天皇誕生日", ID: "emperors-birthday", Kana: "てんのうたんじょうび"},
+			ok:   true,
+		},
+		{
+			// across the end of pre-calculated holidays
+			date: Date{holidaysEndYear + 1, time.January, 1},
+			want: Holiday{Date: fmt.Sprintf("%04d-11-23", holidaysEndYear), Name: "勤労感謝の日", ID: "labor-thanksgiving-day", Kana: "きんろうかんしゃのひ"},
+			ok:   true,
+		},
+		{
+			// across the start of pre-calculated holidays
+			date: Date{holidaysStartYear, time.January, 1},
+			want: Holiday{Date: fmt.Sprintf("%04d-11-23", holidaysStartYear-1), Name: "勤労感謝の日", ID: "labor-thanksgiving-day", Kana: "きんろうかんしゃのひ"},
+			ok:   true,
+		},
+		{
+			// there are no holidays before the law was enacted
+			date: Date{1900, time.January, 1},
+			ok:   false,
+		},
+	}
+	for _, tt := range tests {
+		got, ok := PreviousHoliday(tt.date)
+		if ok != tt.ok {
+			t.Errorf("PreviousHoliday(%s): want %t, got %t", tt.date, tt.ok, ok)
+		}
+		if diff := cmp.Diff(tt.want, got); diff != "" {
+			t.Errorf("PreviousHoliday(%s) mismatch: (-want/+got)\n%s", tt.date, diff)
+		}
+	}
+}
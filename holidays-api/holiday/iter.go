@@ -0,0 +1,56 @@
+package holiday
+
+import (
+	"iter"
+	"sort"
+	"time"
+)
+
+// All returns an iterator over holidays between from and to, inclusive.
+// The holidays are yielded in chronological order.
+func All(from, to Date) iter.Seq[Holiday] {
+	if from.Compare(to) > 0 {
+		from, to = to, from
+	}
+	startDate := from.String()
+	endDate := to.String()
+	if start := calcHolidaysStartYear(); from.Year < start {
+		from = Date{start, time.January, 1}
+	}
+
+	return func(yield func(Holiday) bool) {
+		ds := loadDataset()
+		for m := from.firstDay(); m.Compare(to) <= 0; m = m.nextMonth() {
+			if ds.contains(m.Year) {
+				// yield from pre-calculated holidays
+				idx := sort.Search(len(ds.holidays), func(i int) bool {
+					return ds.holidays[i].Date >= startDate
+				})
+				for _, h := range ds.holidays[idx:] {
+					if h.Date > endDate || !yield(h) {
+						return
+					}
+				}
+				m = Date{ds.endYear, time.December, 1}
+				continue
+			}
+
+			// calculate holidays based on the law
+			for _, h := range calcHolidaysInMonth(m.Year, m.Month) {
+				if h.Date < startDate {
+					continue
+				}
+				if h.Date > endDate || !yield(h) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// From returns an iterator over holidays on or after d.
+// The holidays are yielded in chronological order.
+// The iterator runs until the end of year 9999, so the caller should stop the iteration.
+func From(d Date) iter.Seq[Holiday] {
+	return All(d, Date{calendarMaxYear, time.December, 31})
+}
@@ -0,0 +1,82 @@
+package holiday
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestAll(t *testing.T) {
+	t.Run("pre-calculated", func(t *testing.T) {
+		from := Date{2000, time.January, 1}
+		to := Date{2000, time.December, 31}
+		got := slices.Collect(All(from, to))
+		want := defaultDataset.findHolidaysInYear(2000)
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("holidays not match: (-want/+got)\n%s", diff)
+		}
+	})
+
+	t.Run("reversed", func(t *testing.T) {
+		from := Date{2000, time.January, 10}
+		to := Date{2000, time.January, 1}
+		got := slices.Collect(All(from, to))
+		want := []Holiday{
+			{Date: "2000-01-01", Name: "元日", ID: "new-years-day", Kana: "がんじつ"},
+			{Date: "2000-01-10", Name: "成人の日", ID: "coming-of-age-day", Kana: "せいじんのひ"},
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("holidays not match: (-want/+got)\n%s", diff)
+		}
+	})
+
+	t.Run("across the end of pre-calculated holidays", func(t *testing.T) {
+		from := Date{holidaysEndYear, time.January, 1}
+		to := Date{holidaysEndYear + 1, time.December, 31}
+		got := slices.Collect(All(from, to))
+		want := append(FindHolidaysInYear(holidaysEndYear), FindHolidaysInYear(holidaysEndYear+1)...)
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("holidays not match: (-want/+got)\n%s", diff)
+		}
+	})
+
+	t.Run("across the start of pre-calculated holidays", func(t *testing.T) {
+		from := Date{holidaysStartYear - 1, time.January, 1}
+		to := Date{holidaysStartYear, time.December, 31}
+		got := slices.Collect(All(from, to))
+		want := append(FindHolidaysInYear(holidaysStartYear-1), FindHolidaysInYear(holidaysStartYear)...)
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("holidays not match: (-want/+got)\n%s", diff)
+		}
+	})
+
+	t.Run("before the law", func(t *testing.T) {
+		from := Date{1900, time.January, 1}
+		to := Date{1900, time.December, 31}
+		got := slices.Collect(All(from, to))
+		if len(got) != 0 {
+			t.Errorf("want no holidays, got %v", got)
+		}
+	})
+}
+
+func TestFrom(t *testing.T) {
+	var got []Holiday
+	for h := range From(Date{holidaysEndYear, time.November, 4}) {
+		got = append(got, h)
+		if len(got) == 3 {
+			break
+		}
+	}
+	want := []Holiday{
+		{Date: fmt.Sprintf("%04d-11-23", holidaysEndYear), Name: "勤労感謝の日", ID: "labor-thanksgiving-day", Kana: "きんろうかんしゃのひ"},
+		{Date: fmt.Sprintf("%04d-01-01", holidaysEndYear+1), Name: "元日", ID: "new-years-day", Kana: "がんじつ"},
+	}
+	want = append(want, FindHolidaysInMonth(holidaysEndYear+1, time.January)[1])
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("holidays not match: (-want/+got)\n%s", diff)
+	}
+}
@@ -0,0 +1,50 @@
+package holiday
+
+import "strconv"
+
+// Kind is a kind of holidays.
+type Kind int
+
+const (
+	// KindNational is a national holiday (国民の祝日) defined by Article 2 of
+	// the Act on National Holidays (国民の祝日に関する法律).
+	KindNational Kind = iota
+
+	// KindSubstitute is a substitute holiday (振替休日) defined by Article 3-2 of
+	// the Act on National Holidays.
+	// It is the day after a national holiday that falls on Sunday.
+	KindSubstitute
+
+	// KindCitizens is a citizens' holiday (国民の休日) defined by Article 3-3 of
+	// the Act on National Holidays.
+	// It is the day sandwiched between two national holidays.
+	KindCitizens
+
+	// KindSpecial is a one-off holiday that is treated as a national holiday by a special law.
+	// e.g. the day of the enthronement of the Emperor in 2019.
+	KindSpecial
+
+	// KindImperialCeremony is a one-off holiday for an imperial ceremony.
+	// e.g. the wedding ceremony of the Crown Prince and the funeral ceremony of the Emperor.
+	KindImperialCeremony
+
+	// KindCustom is a holiday added by an Overlay.
+	// e.g. company holidays.
+	KindCustom
+)
+
+var kindNames = [...]string{
+	KindNational:         "national",
+	KindSubstitute:       "substitute",
+	KindCitizens:         "citizens",
+	KindSpecial:          "special",
+	KindImperialCeremony: "imperial-ceremony",
+	KindCustom:           "custom",
+}
+
+func (k Kind) String() string {
+	if 0 <= k && int(k) < len(kindNames) {
+		return kindNames[k]
+	}
+	return "Kind(" + strconv.Itoa(int(k)) + ")"
+}
@@ -0,0 +1,42 @@
+package holiday
+
+import (
+	"testing"
+	"time"
+)
+
+func TestKind(t *testing.T) {
+	tests := []struct {
+		date Date
+		kind Kind
+	}{
+		{Date{2019, time.April, 29}, KindNational},
+		{Date{2019, time.April, 30}, KindCitizens},
+		{Date{2019, time.May, 1}, KindSpecial},
+		{Date{2019, time.May, 6}, KindSubstitute},
+		{Date{1989, time.February, 24}, KindImperialCeremony},
+
+		// calculated holidays
+		{Date{2029, time.April, 30}, KindSubstitute},
+		{Date{2032, time.September, 21}, KindCitizens},
+	}
+	for _, tt := range tests {
+		h, ok := FindHoliday(tt.date.Year, tt.date.Month, tt.date.Day)
+		if !ok {
+			t.Errorf("%s is not a holiday", tt.date)
+			continue
+		}
+		if h.Kind != tt.kind {
+			t.Errorf("%s: want %s, got %s", tt.date, tt.kind, h.Kind)
+		}
+	}
+}
+
+func TestKind_String(t *testing.T) {
+	if got, want := KindImperialCeremony.String(), "imperial-ceremony"; got != want {
+		t.Errorf("want %q, got %q", want, got)
+	}
+	if got, want := Kind(-1).String(), "Kind(-1)"; got != want {
+		t.Errorf("want %q, got %q", want, got)
+	}
+}
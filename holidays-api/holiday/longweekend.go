@@ -0,0 +1,67 @@
+package holiday
+
+import "time"
+
+// minLongWeekendDays is the minimum number of days of a long weekend.
+const minLongWeekendDays = 3
+
+// LongWeekend is a stretch of consecutive days off (連休),
+// such as Golden Week, Silver Week and three-day weekends.
+type LongWeekend struct {
+	// Start is the first day of the stretch.
+	Start Date
+
+	// End is the last day of the stretch.
+	End Date
+
+	// Holidays are the holidays in the stretch.
+	Holidays []Holiday
+}
+
+// Days returns the number of days in the stretch.
+func (lw LongWeekend) Days() int {
+	return int(lw.End.Time(time.UTC).Sub(lw.Start.Time(time.UTC)).Hours()/24) + 1
+}
+
+// LongWeekendsInYear returns the long weekends of three or more days that overlap the year.
+// A long weekend over the new year is returned for both years.
+func LongWeekendsInYear(year int) []LongWeekend {
+	return national.LongWeekendsInYear(year)
+}
+
+// LongWeekendsInYear returns the long weekends of three or more days in the calendar that overlap the year.
+// The days off are the days that are not business days in the calendar.
+// A long weekend over the new year is returned for both years.
+func (c *Calendar) LongWeekendsInYear(year int) []LongWeekend {
+	checker := businessDayChecker{calendar: c}
+	first := Date{year, time.January, 1}
+	last := Date{year, time.December, 31}
+
+	var ret []LongWeekend
+	d := first
+	for d.Compare(last) <= 0 {
+		if checker.isBusinessDay(d) {
+			d = d.AddDays(1)
+			continue
+		}
+
+		// extend the stretch to both directions.
+		start, end := d, d
+		if start == first {
+			for !checker.isBusinessDay(start.AddDays(-1)) {
+				start = start.AddDays(-1)
+			}
+		}
+		for !checker.isBusinessDay(end.AddDays(1)) {
+			end = end.AddDays(1)
+		}
+
+		lw := LongWeekend{Start: start, End: end}
+		if lw.Days() >= minLongWeekendDays {
+			lw.Holidays = c.FindHolidaysInRange(start, end)
+			ret = append(ret, lw)
+		}
+		d = end.AddDays(1)
+	}
+	return ret
+}
@@ -0,0 +1,54 @@
+package holiday
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLongWeekendsInYear(t *testing.T) {
+	got := LongWeekendsInYear(2025)
+	want := []struct {
+		start, end Date
+		holidays   int
+	}{
+		{Date{2025, time.January, 11}, Date{2025, time.January, 13}, 1},   // 成人の日
+		{Date{2025, time.February, 22}, Date{2025, time.February, 24}, 2}, // 天皇誕生日, 振替休日
+		{Date{2025, time.May, 3}, Date{2025, time.May, 6}, 4},             // Golden Week
+		{Date{2025, time.July, 19}, Date{2025, time.July, 21}, 1},         // 海の日
+		{Date{2025, time.August, 9}, Date{2025, time.August, 11}, 1},      // 山の日
+		{Date{2025, time.September, 13}, Date{2025, time.September, 15}, 1},
+		{Date{2025, time.October, 11}, Date{2025, time.October, 13}, 1},
+		{Date{2025, time.November, 1}, Date{2025, time.November, 3}, 1},
+		{Date{2025, time.November, 22}, Date{2025, time.November, 24}, 2},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("want %d long weekends, got %d: %v", len(want), len(got), got)
+	}
+	for i, w := range want {
+		if got[i].Start != w.start || got[i].End != w.end || len(got[i].Holidays) != w.holidays {
+			t.Errorf("want %s - %s with %d holidays, got %s - %s with %v", w.start, w.end, w.holidays, got[i].Start, got[i].End, got[i].Holidays)
+		}
+	}
+	if got[2].Days() != 4 {
+		t.Errorf("Golden Week: want 4 days, got %d", got[2].Days())
+	}
+}
+
+func TestCalendar_LongWeekendsInYear(t *testing.T) {
+	// the new year holidays of banks: 2024-12-31 (Tue) - 2025-01-05 (Sun)
+	want := LongWeekend{Start: Date{2024, time.December, 31}, End: Date{2025, time.January, 5}}
+	for _, year := range []int{2024, 2025} {
+		var found bool
+		for _, lw := range Bank().LongWeekendsInYear(year) {
+			if lw.Start == want.Start && lw.End == want.End {
+				found = true
+				if lw.Days() != 6 {
+					t.Errorf("want 6 days, got %d", lw.Days())
+				}
+			}
+		}
+		if !found {
+			t.Errorf("%d: the new year holidays are not found", year)
+		}
+	}
+}
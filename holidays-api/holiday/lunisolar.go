@@ -0,0 +1,180 @@
+package holiday
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// LunisolarDate is a date in the Japanese lunisolar calendar (旧暦).
+//
+// The calendar follows the rules of the Tenpō calendar (天保暦) used until 1872:
+// a month begins on the day of the new moon in Japan Standard Time,
+// the month that contains 冬至 is the 11th month,
+// and if there are 13 months between 11th months, the first month that contains no 中気 is a leap month (閏月).
+type LunisolarDate struct {
+	Year  int
+	Month int  // 1 to 12
+	Leap  bool // the month is a leap month (閏月).
+	Day   int  // 1 to 30
+}
+
+// String returns the date in the "2025年閏6月1日" format.
+func (ld LunisolarDate) String() string {
+	var buf strings.Builder
+	buf.WriteString(strconv.Itoa(ld.Year))
+	buf.WriteString("年")
+	if ld.Leap {
+		buf.WriteString("閏")
+	}
+	buf.WriteString(strconv.Itoa(ld.Month))
+	buf.WriteString("月")
+	buf.WriteString(strconv.Itoa(ld.Day))
+	buf.WriteString("日")
+	return buf.String()
+}
+
+// Lunisolar converts d to the Japanese lunisolar calendar (旧暦).
+// The conversion is based on the approximation of the new moons and the solar terms,
+// so the dates may differ from the published calendars when a new moon or 中気 is close to midnight.
+func (d Date) Lunisolar() LunisolarDate {
+	k := lunationOnOrBefore(d)
+
+	// search the 11th month that contains 冬至.
+	year := d.Year
+	k11 := lunationOnOrBefore(FromTime(SolarTermToji.In(year)))
+	if k11 > k {
+		year--
+		k11 = lunationOnOrBefore(FromTime(SolarTermToji.In(year)))
+	}
+	next := lunationOnOrBefore(FromTime(SolarTermToji.In(year + 1)))
+
+	leap := -1
+	if next-k11 == 13 {
+		for i := k11 + 1; i < next; i++ {
+			if !hasChuki(i) {
+				leap = i
+				break
+			}
+		}
+	}
+
+	month := 11
+	for i := k11 + 1; i <= k; i++ {
+		if i != leap {
+			month++
+		}
+	}
+	if month > 12 {
+		month -= 12
+		year++
+	}
+
+	start := newMoonDate(k)
+	return LunisolarDate{
+		Year:  year,
+		Month: month,
+		Leap:  k == leap,
+		Day:   int(d.Time(jst).Sub(start.Time(jst)).Hours()/24+0.5) + 1,
+	}
+}
+
+// hasChuki reports whether the k-th lunation contains 中気,
+// the solar terms whose ecliptic longitude of the sun is a multiple of 30°.
+func hasChuki(k int) bool {
+	a := sunLongitude(time2JulianYear(newMoonDate(k).Time(jst)))
+	b := sunLongitude(time2JulianYear(newMoonDate(k + 1).Time(jst)))
+	if b < a {
+		b += 360
+	}
+	return math.Floor(b/30) > math.Floor(a/30)
+}
+
+// synodicMonth is the mean length of the lunation in days.
+const synodicMonth = 29.530588861
+
+// lunationOnOrBefore returns the number of the lunation that contains d.
+// The lunation 0 begins at the new moon on 2000-01-07 in Japan Standard Time.
+func lunationOnOrBefore(d Date) int {
+	days := float64(d.Time(jst).Unix()-newMoonTime(0).Unix()) / (24 * 60 * 60)
+	k := int(math.Floor(days / synodicMonth))
+	for newMoonDate(k+1).Compare(d) <= 0 {
+		k++
+	}
+	for newMoonDate(k).After(d) {
+		k--
+	}
+	return k
+}
+
+// newMoonDate returns the date of the k-th new moon in Japan Standard Time.
+func newMoonDate(k int) Date {
+	return FromTime(newMoonTime(k))
+}
+
+// newMoonTime returns the instant of the k-th new moon.
+// from Jean Meeus(1998) "Astronomical Algorithms" 2nd edition, Chapter 49.
+func newMoonTime(k int) time.Time {
+	kk := float64(k)
+	t := kk / 1236.85
+	t2 := t * t
+	t3 := t2 * t
+	t4 := t3 * t
+
+	jde := 2451550.09766 + synodicMonth*kk + 0.00015437*t2 - 0.000000150*t3 + 0.00000000073*t4
+	e := 1 - 0.002516*t - 0.0000074*t2
+	m := 2.5534 + 29.10535670*kk - 0.0000014*t2 - 0.00000011*t3                      // the mean anomaly of the sun
+	mm := 201.5643 + 385.81693528*kk + 0.0107582*t2 + 0.00001238*t3 - 0.000000058*t4 // the mean anomaly of the moon
+	f := 160.7108 + 390.67050284*kk - 0.0016118*t2 - 0.00000227*t3 + 0.000000011*t4  // the argument of latitude of the moon
+	omega := 124.7746 - 1.56375588*kk + 0.0020672*t2 + 0.00000215*t3                 // the longitude of the ascending node
+
+	jde += -0.40720*sin(mm) +
+		0.17241*e*sin(m) +
+		0.01608*sin(2*mm) +
+		0.01039*sin(2*f) +
+		0.00739*e*sin(mm-m) -
+		0.00514*e*sin(mm+m) +
+		0.00208*e*e*sin(2*m) -
+		0.00111*sin(mm-2*f) -
+		0.00057*sin(mm+2*f) +
+		0.00056*e*sin(2*mm+m) -
+		0.00042*sin(3*mm) +
+		0.00042*e*sin(m+2*f) +
+		0.00038*e*sin(m-2*f) -
+		0.00024*e*sin(2*mm-m) -
+		0.00017*sin(omega) -
+		0.00007*sin(mm+2*m) +
+		0.00004*sin(2*mm-2*f) +
+		0.00004*sin(3*m) +
+		0.00003*sin(mm+m-2*f) +
+		0.00003*sin(2*mm+2*f) -
+		0.00003*sin(mm+m+2*f) +
+		0.00003*sin(mm-m+2*f) -
+		0.00002*sin(mm-m-2*f) -
+		0.00002*sin(3*mm+m) +
+		0.00002*sin(4*mm)
+
+	// the planetary arguments
+	jde += 0.000325*sin(299.77+0.107408*kk-0.009173*t2) +
+		0.000165*sin(251.88+0.016321*kk) +
+		0.000164*sin(251.83+26.651886*kk) +
+		0.000126*sin(349.42+36.412478*kk) +
+		0.000110*sin(84.66+18.206239*kk) +
+		0.000062*sin(141.74+53.303771*kk) +
+		0.000060*sin(207.14+2.453732*kk) +
+		0.000056*sin(154.84+7.306860*kk) +
+		0.000047*sin(34.52+27.261239*kk) +
+		0.000042*sin(207.19+0.121824*kk) +
+		0.000040*sin(291.34+1.844379*kk) +
+		0.000037*sin(161.72+24.198154*kk) +
+		0.000035*sin(239.56+25.513099*kk) +
+		0.000023*sin(331.55+3.592518*kk)
+
+	// convert the Julian Ephemeris Day into the unix time.
+	// JD 2440587.5 is 1970-01-01 00:00:00 UTC.
+	sec := (jde - 2440587.5) * 24 * 60 * 60
+	sec -= 36 + 32 // convert TT(Terrestrial Time) into UTC. see time2JulianYear.
+	s := math.Floor(sec)
+	return time.Unix(int64(s), int64((sec-s)*1e9)).In(jst)
+}
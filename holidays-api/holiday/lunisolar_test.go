@@ -0,0 +1,62 @@
+package holiday
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDate_Lunisolar(t *testing.T) {
+	tests := []struct {
+		date Date
+		want LunisolarDate
+	}{
+		{Date{1900, time.January, 1}, LunisolarDate{1899, 12, false, 1}},
+		{Date{2020, time.May, 22}, LunisolarDate{2020, 4, false, 30}},
+		{Date{2020, time.May, 23}, LunisolarDate{2020, 4, true, 1}},
+		{Date{2023, time.March, 22}, LunisolarDate{2023, 2, true, 1}},
+		{Date{2024, time.December, 31}, LunisolarDate{2024, 12, false, 1}},
+		{Date{2025, time.January, 28}, LunisolarDate{2024, 12, false, 29}},
+		{Date{2025, time.January, 29}, LunisolarDate{2025, 1, false, 1}},
+		{Date{2025, time.July, 24}, LunisolarDate{2025, 6, false, 30}},
+		{Date{2025, time.July, 25}, LunisolarDate{2025, 6, true, 1}},
+		{Date{2025, time.August, 23}, LunisolarDate{2025, 7, false, 1}},
+
+		// the problem of the year 2033 (旧暦2033年問題).
+		// the leap month is in the 11th month by the rule of the 13 months.
+		{Date{2033, time.December, 22}, LunisolarDate{2033, 11, true, 1}},
+		{Date{2034, time.January, 20}, LunisolarDate{2033, 12, false, 1}},
+		{Date{2034, time.February, 19}, LunisolarDate{2034, 1, false, 1}},
+	}
+	for _, tt := range tests {
+		if got := tt.date.Lunisolar(); got != tt.want {
+			t.Errorf("%s: want %s, got %s", tt.date, tt.want, got)
+		}
+	}
+}
+
+func TestNewMoonTime(t *testing.T) {
+	// 国立天文台 暦計算室
+	tests := []struct {
+		k    int
+		want time.Time
+	}{
+		{0, time.Date(2000, time.January, 7, 3, 14, 0, 0, jst)},
+		{309, time.Date(2024, time.December, 31, 7, 27, 0, 0, jst)},
+		{315, time.Date(2025, time.June, 25, 19, 31, 0, 0, jst)},
+	}
+	for _, tt := range tests {
+		got := newMoonTime(tt.k)
+		if diff := got.Sub(tt.want).Abs(); diff > 2*time.Minute {
+			t.Errorf("%d: want %s, got %s", tt.k, tt.want, got)
+		}
+	}
+}
+
+func TestLunisolarDate_String(t *testing.T) {
+	if got, want := (LunisolarDate{2025, 6, true, 1}).String(), "2025年閏6月1日"; got != want {
+		t.Errorf("want %q, got %q", want, got)
+	}
+	if got, want := (LunisolarDate{2025, 1, false, 29}).String(), "2025年1月29日"; got != want {
+		t.Errorf("want %q, got %q", want, got)
+	}
+}
@@ -0,0 +1,103 @@
+package holiday
+
+import "time"
+
+// Market is the trading calendar of a stock exchange.
+// The market is closed on Saturdays, Sundays, the national holidays and its own closing days.
+type Market struct {
+	calendar *Calendar
+
+	// halfDaySessionsUntil is the last year that the first and last trading days of the year are half-day sessions.
+	halfDaySessionsUntil int
+}
+
+// jpxOverlay is the closing days of the Japan Exchange Group other than the national holidays.
+// Unscheduled trading halts, such as the failure of arrowhead on 2020-10-01, are not closing days:
+// the clearing and settlement ran, and the day was a business day.
+var jpxOverlay = &Overlay{
+	Holidays: []OverlayDate{
+		{Date: "12-31", Name: "年末休業日"},
+		{Date: "01-02", Name: "年始休業日"},
+		{Date: "01-03", Name: "年始休業日"},
+	},
+}
+
+var jpx = &Market{
+	calendar:             mustNewCalendar(jpxOverlay),
+	halfDaySessionsUntil: 2008,
+}
+
+// JPX returns the trading calendar of the Japan Exchange Group (日本取引所グループ),
+// including the Tokyo Stock Exchange.
+// The market is closed on December 31 and January 2 and 3 in addition to the national holidays.
+// The first and last trading days of the year (大発会 and 大納会) were half-day sessions until 2008.
+// The Saturday sessions before February 1989 are not supported.
+func JPX() *Market {
+	return jpx
+}
+
+// Calendar returns the calendar of the closing days of the market.
+func (m *Market) Calendar() *Calendar {
+	return m.calendar
+}
+
+// IsTradingDay reports whether d is a trading day.
+func (m *Market) IsTradingDay(d Date) bool {
+	return m.calendar.IsBusinessDay(d)
+}
+
+// NextTradingDay returns the first trading day after d.
+func (m *Market) NextTradingDay(d Date) Date {
+	return m.calendar.NextBusinessDay(d)
+}
+
+// PreviousTradingDay returns the last trading day before d.
+func (m *Market) PreviousTradingDay(d Date) Date {
+	return m.calendar.PreviousBusinessDay(d)
+}
+
+// AddTradingDays returns the date n trading days after d.
+// If n is negative, it returns the date -n trading days before d.
+// If n is zero, it returns d even if d is not a trading day.
+func (m *Market) AddTradingDays(d Date, n int) Date {
+	return m.calendar.AddBusinessDays(d, n)
+}
+
+// TradingDaysBetween returns the number of trading days
+// after from and on or before to.
+// If to is before from, it returns the negated number of trading days
+// after to and on or before from.
+func (m *Market) TradingDaysBetween(from, to Date) int {
+	return m.calendar.BusinessDaysBetween(from, to)
+}
+
+// TradingDaysInYear returns the number of trading days in the year.
+func (m *Market) TradingDaysInYear(year int) int {
+	return m.TradingDaysBetween(Date{year - 1, time.December, 31}, Date{year, time.December, 31})
+}
+
+// ClosingDaysInYear returns the national holidays and the closing days of the market in the year.
+// Saturdays and Sundays are not included unless they are holidays.
+func (m *Market) ClosingDaysInYear(year int) []Holiday {
+	return m.calendar.FindHolidaysInYear(year)
+}
+
+// IsHalfDay reports whether d is a half-day session (半日立会).
+func (m *Market) IsHalfDay(d Date) bool {
+	for _, h := range m.HalfDaysInYear(d.Year) {
+		if h == d {
+			return true
+		}
+	}
+	return false
+}
+
+// HalfDaysInYear returns the half-day sessions in the year.
+func (m *Market) HalfDaysInYear(year int) []Date {
+	if year > m.halfDaySessionsUntil {
+		return nil
+	}
+	first := m.NextTradingDay(Date{year - 1, time.December, 31})
+	last := m.PreviousTradingDay(Date{year + 1, time.January, 1})
+	return []Date{first, last}
+}
@@ -0,0 +1,79 @@
+package holiday
+
+import (
+	"testing"
+	"time"
+)
+
+func TestJPX_IsTradingDay(t *testing.T) {
+	jpx := JPX()
+	tests := []struct {
+		date Date
+		want bool
+	}{
+		{Date{2024, time.December, 30}, true},  // 大納会
+		{Date{2024, time.December, 31}, false}, // 年末休業日
+		{Date{2025, time.January, 1}, false},   // 元日
+		{Date{2025, time.January, 2}, false},   // 年始休業日
+		{Date{2025, time.January, 3}, false},   // 年始休業日
+		{Date{2025, time.January, 6}, true},    // 大発会
+		{Date{2025, time.January, 13}, false},  // 成人の日
+		{Date{2025, time.May, 6}, false},       // 振替休日
+		{Date{2025, time.May, 10}, false},      // Saturday
+		{Date{2020, time.October, 1}, true},    // the trading was halted, but the market was open
+		{Date{2020, time.October, 2}, true},
+	}
+	for _, tt := range tests {
+		if got := jpx.IsTradingDay(tt.date); got != tt.want {
+			t.Errorf("%s: want %t, got %t", tt.date, tt.want, got)
+		}
+	}
+}
+
+func TestJPX_NextTradingDay(t *testing.T) {
+	jpx := JPX()
+	if got, want := jpx.NextTradingDay(Date{2024, time.December, 30}), (Date{2025, time.January, 6}); got != want {
+		t.Errorf("want %s, got %s", want, got)
+	}
+	if got, want := jpx.PreviousTradingDay(Date{2025, time.January, 6}), (Date{2024, time.December, 30}); got != want {
+		t.Errorf("want %s, got %s", want, got)
+	}
+	if got, want := jpx.AddTradingDays(Date{2024, time.December, 27}, 2), (Date{2025, time.January, 6}); got != want {
+		t.Errorf("want %s, got %s", want, got)
+	}
+}
+
+func TestJPX_TradingDaysInYear(t *testing.T) {
+	jpx := JPX()
+	tests := []struct {
+		year int
+		want int
+	}{
+		{2023, 246},
+		{2024, 245},
+		{2025, 243},
+	}
+	for _, tt := range tests {
+		if got := jpx.TradingDaysInYear(tt.year); got != tt.want {
+			t.Errorf("%d: want %d, got %d", tt.year, tt.want, got)
+		}
+	}
+}
+
+func TestJPX_HalfDays(t *testing.T) {
+	jpx := JPX()
+	if got := jpx.HalfDaysInYear(2009); got != nil {
+		t.Errorf("want no half-day sessions, got %v", got)
+	}
+	got := jpx.HalfDaysInYear(2008)
+	want := []Date{{2008, time.January, 4}, {2008, time.December, 30}}
+	if len(got) != 2 || got[0] != want[0] || got[1] != want[1] {
+		t.Errorf("want %v, got %v", want, got)
+	}
+	if !jpx.IsHalfDay(Date{2008, time.January, 4}) {
+		t.Error("2008-01-04 is a half-day session")
+	}
+	if jpx.IsHalfDay(Date{2008, time.January, 7}) {
+		t.Error("2008-01-07 is not a half-day session")
+	}
+}
@@ -0,0 +1,162 @@
+package holiday
+
+// Language is a language of holiday names.
+// It is a BCP 47 language tag.
+type Language string
+
+const (
+	// Japanese is the official name in Japanese.
+	Japanese Language = "ja"
+
+	// English is the name in English.
+	English Language = "en"
+
+	// Romaji is the official name romanized in the (modified) Hepburn romanization.
+	Romaji Language = "ja-Latn"
+)
+
+// LocalizedName returns the name of the holiday in the lang.
+// If the translation is not available, it returns the official name in Japanese.
+func (h Holiday) LocalizedName(lang Language) string {
+	if lang == Japanese {
+		return h.Name
+	}
+
+	t, ok := renamedTranslations[h.Name]
+	if !ok {
+		t, ok = translations[holidayID(h)]
+	}
+	if !ok {
+		return h.Name
+	}
+
+	switch lang {
+	case English:
+		return t.English
+	case Romaji:
+		return t.Romaji
+	}
+	return h.Name
+}
+
+// holidayID returns the identifier of the holiday.
+// The identifier is stable even if the holiday is renamed.
+// If h.ID is empty, it is guessed from the name.
+func holidayID(h Holiday) string {
+	if h.ID != "" {
+		return h.ID
+	}
+	switch h.Name {
+	case "休日":
+		if h.Kind == KindSubstitute {
+			return "substitute-holiday"
+		}
+		return "citizens-holiday"
+	case "休日（祝日扱い）":
+		// the Cabinet Office uses the same name for these days.
+		switch h.Date {
+		case "2019-05-01":
+			return "enthronement-day"
+		case "2019-10-22":
+			return "enthronement-ceremony"
+		}
+	}
+	return holidayIDs[h.Name]
+}
+
+// IsHolidayID reports whether id is an identifier of the holidays. e.g. "sports-day"
+func IsHolidayID(id string) bool {
+	_, ok := translations[id]
+	return ok
+}
+
+// holidayIDs is a map from the official name in Japanese to the identifier.
+var holidayIDs = map[string]string{
+	"元日":           "new-years-day",
+	"成人の日":         "coming-of-age-day",
+	"建国記念の日":       "national-foundation-day",
+	"天皇誕生日":        "emperors-birthday",
+	"春分の日":         "vernal-equinox-day",
+	"昭和の日":         "showa-day",
+	"憲法記念日":        "constitution-memorial-day",
+	"みどりの日":        "greenery-day",
+	"こどもの日":        "childrens-day",
+	"海の日":          "marine-day",
+	"山の日":          "mountain-day",
+	"敬老の日":         "respect-for-the-aged-day",
+	"秋分の日":         "autumnal-equinox-day",
+	"体育の日":         "sports-day",
+	"体育の日（スポーツの日）": "sports-day",
+	"スポーツの日":       "sports-day",
+	"文化の日":         "culture-day",
+	"勤労感謝の日":       "labor-thanksgiving-day",
+	"結婚の儀":         "imperial-wedding-ceremony",
+	"大喪の礼":         "imperial-funeral-ceremony",
+	"即位礼正殿の儀":      "enthronement-ceremony",
+}
+
+// holidayKanas is a map from the official name in Japanese to the reading in hiragana.
+var holidayKanas = map[string]string{
+	"元日":           "がんじつ",
+	"成人の日":         "せいじんのひ",
+	"建国記念の日":       "けんこくきねんのひ",
+	"天皇誕生日":        "てんのうたんじょうび",
+	"春分の日":         "しゅんぶんのひ",
+	"昭和の日":         "しょうわのひ",
+	"憲法記念日":        "けんぽうきねんび",
+	"みどりの日":        "みどりのひ",
+	"こどもの日":        "こどものひ",
+	"海の日":          "うみのひ",
+	"山の日":          "やまのひ",
+	"敬老の日":         "けいろうのひ",
+	"秋分の日":         "しゅうぶんのひ",
+	"体育の日":         "たいいくのひ",
+	"体育の日（スポーツの日）": "たいいくのひ（すぽーつのひ）",
+	"スポーツの日":       "すぽーつのひ",
+	"文化の日":         "ぶんかのひ",
+	"勤労感謝の日":       "きんろうかんしゃのひ",
+	"結婚の儀":         "けっこんのぎ",
+	"大喪の礼":         "たいそうのれい",
+	"即位礼正殿の儀":      "そくいれいせいでんのぎ",
+	"休日":           "きゅうじつ",
+	"休日（祝日扱い）":     "きゅうじつ（しゅくじつあつかい）",
+}
+
+type translation struct {
+	English string
+	Romaji  string
+}
+
+// translations is a map from the identifier to the translated names.
+var translations = map[string]translation{
+	"new-years-day":             {"New Year's Day", "Ganjitsu"},
+	"coming-of-age-day":         {"Coming of Age Day", "Seijin no Hi"},
+	"national-foundation-day":   {"National Foundation Day", "Kenkoku Kinen no Hi"},
+	"emperors-birthday":         {"The Emperor's Birthday", "Tennō Tanjōbi"},
+	"vernal-equinox-day":        {"Vernal Equinox Day", "Shunbun no Hi"},
+	"showa-day":                 {"Shōwa Day", "Shōwa no Hi"},
+	"constitution-memorial-day": {"Constitution Memorial Day", "Kenpō Kinenbi"},
+	"greenery-day":              {"Greenery Day", "Midori no Hi"},
+	"childrens-day":             {"Children's Day", "Kodomo no Hi"},
+	"marine-day":                {"Marine Day", "Umi no Hi"},
+	"mountain-day":              {"Mountain Day", "Yama no Hi"},
+	"respect-for-the-aged-day":  {"Respect for the Aged Day", "Keirō no Hi"},
+	"autumnal-equinox-day":      {"Autumnal Equinox Day", "Shūbun no Hi"},
+	"sports-day":                {"Sports Day", "Supōtsu no Hi"},
+	"culture-day":               {"Culture Day", "Bunka no Hi"},
+	"labor-thanksgiving-day":    {"Labor Thanksgiving Day", "Kinrō Kansha no Hi"},
+	"substitute-holiday":        {"Substitute Holiday", "Furikae Kyūjitsu"},
+	"citizens-holiday":          {"Citizens' Holiday", "Kokumin no Kyūjitsu"},
+	"imperial-wedding-ceremony": {"Imperial Wedding Ceremony", "Kekkon no Gi"},
+	"imperial-funeral-ceremony": {"Funeral Ceremony of Emperor Shōwa", "Taisō no Rei"},
+	"enthronement-ceremony":     {"Enthronement Ceremony", "Sokuirei Seiden no Gi"},
+	"enthronement-day":          {"Enthronement Day", "Tennō no Sokui no Hi"},
+}
+
+// renamedTranslations is a map from the former names in Japanese to the translated names.
+// Holidays that were renamed share the same identifier,
+// so the translations of the former names are managed separately.
+var renamedTranslations = map[string]translation{
+	"体育の日":         {"Health and Sports Day", "Taiiku no Hi"},
+	"体育の日（スポーツの日）": {"Health and Sports Day (Sports Day)", "Taiiku no Hi (Supōtsu no Hi)"},
+}
@@ -0,0 +1,107 @@
+package holiday
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLocalizedName(t *testing.T) {
+	tests := []struct {
+		date   Date
+		lang   Language
+		want   string
+		wantID string
+	}{
+		{Date{2025, time.March, 20}, Japanese, "春分の日", "vernal-equinox-day"},
+		{Date{2025, time.March, 20}, English, "Vernal Equinox Day", "vernal-equinox-day"},
+		{Date{2025, time.March, 20}, Romaji, "Shunbun no Hi", "vernal-equinox-day"},
+		{Date{2000, time.October, 9}, English, "Health and Sports Day", "sports-day"},
+		{Date{2020, time.July, 24}, English, "Sports Day", "sports-day"},
+		{Date{2025, time.May, 6}, English, "Substitute Holiday", "substitute-holiday"},
+		{Date{2019, time.April, 30}, English, "Citizens' Holiday", "citizens-holiday"},
+		{Date{2019, time.May, 1}, Romaji, "Tennō no Sokui no Hi", "enthronement-day"},
+		{Date{2019, time.May, 1}, Language("fr"), "休日（祝日扱い）", "enthronement-day"},
+	}
+	for _, tt := range tests {
+		h, ok := FindHoliday(tt.date.Year, tt.date.Month, tt.date.Day)
+		if !ok {
+			t.Errorf("%s is not a holiday", tt.date)
+			continue
+		}
+		if got := h.LocalizedName(tt.lang); got != tt.want {
+			t.Errorf("%s in %s: want %q, got %q", tt.date, tt.lang, tt.want, got)
+		}
+		if got := holidayID(h); got != tt.wantID {
+			t.Errorf("%s: want id %q, got %q", tt.date, tt.wantID, got)
+		}
+	}
+}
+
+func TestLocalizedName_AllHolidays(t *testing.T) {
+	for _, h := range holidays {
+		id := holidayID(h)
+		if id == "" {
+			t.Errorf("%s %s: no identifier", h.Date, h.Name)
+			continue
+		}
+		if _, ok := translations[id]; !ok {
+			t.Errorf("%s %s: no translation for %q", h.Date, h.Name, id)
+		}
+	}
+	for _, rule := range defaultRuleSet.rules {
+		for _, d := range rule.StaticHolydays {
+			if id := holidayIDs[d.Name]; id == "" || id != d.ID {
+				t.Errorf("%d %s: unexpected identifier: want %q, got %q", rule.BeginYear, d.Name, id, d.ID)
+			}
+		}
+		for _, d := range rule.WeekdayHolydays {
+			if id := holidayIDs[d.Name]; id == "" || id != d.ID {
+				t.Errorf("%d %s: unexpected identifier: want %q, got %q", rule.BeginYear, d.Name, id, d.ID)
+			}
+		}
+		for _, d := range rule.EquinoxHolydays {
+			if id := holidayIDs[d.Name]; id == "" || id != d.ID {
+				t.Errorf("%d %s: unexpected identifier: want %q, got %q", rule.BeginYear, d.Name, id, d.ID)
+			}
+		}
+	}
+}
+
+func TestIsHolidayID(t *testing.T) {
+	if !IsHolidayID("sports-day") {
+		t.Error("sports-day should be an identifier")
+	}
+	if IsHolidayID("スポーツの日") {
+		t.Error("スポーツの日 should not be an identifier")
+	}
+}
+
+func TestHolidayKanas(t *testing.T) {
+	for _, h := range holidays {
+		if want := holidayKanas[h.Name]; want == "" || h.Kana != want {
+			t.Errorf("%s %s: unexpected reading: want %q, got %q", h.Date, h.Name, want, h.Kana)
+		}
+	}
+	for _, h := range defaultRuleSet.special {
+		if want := holidayKanas[h.Name]; want == "" || h.Kana != want {
+			t.Errorf("%s %s: unexpected reading: want %q, got %q", h.Date, h.Name, want, h.Kana)
+		}
+	}
+	for _, rule := range defaultRuleSet.rules {
+		for _, d := range rule.StaticHolydays {
+			if want := holidayKanas[d.Name]; want == "" || d.Kana != want {
+				t.Errorf("%d %s: unexpected reading: want %q, got %q", rule.BeginYear, d.Name, want, d.Kana)
+			}
+		}
+		for _, d := range rule.WeekdayHolydays {
+			if want := holidayKanas[d.Name]; want == "" || d.Kana != want {
+				t.Errorf("%d %s: unexpected reading: want %q, got %q", rule.BeginYear, d.Name, want, d.Kana)
+			}
+		}
+		for _, d := range rule.EquinoxHolydays {
+			if want := holidayKanas[d.Name]; want == "" || d.Kana != want {
+				t.Errorf("%d %s: unexpected reading: want %q, got %q", rule.BeginYear, d.Name, want, d.Kana)
+			}
+		}
+	}
+}
@@ -0,0 +1,216 @@
+package holiday
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Overlay is a set of changes to the national holidays,
+// such as company holidays and extra working days.
+type Overlay struct {
+	// Holidays are the days that are added as holidays.
+	Holidays []OverlayDate `json:"holidays" yaml:"holidays"`
+
+	// WorkingDays are the days that are working days
+	// even if they are national holidays or weekends.
+	WorkingDays []OverlayDate `json:"working_days" yaml:"working_days"`
+}
+
+// OverlayDate is a date in an Overlay.
+type OverlayDate struct {
+	// Date is a date in the "2006-01-02" format,
+	// or in the "01-02" format for the date that repeats every year.
+	Date string `json:"date" yaml:"date"`
+
+	// Name is the name of the day.
+	Name string `json:"name" yaml:"name"`
+}
+
+// ParseOverlayJSON parses an overlay in the JSON format.
+//
+//	{
+//	  "holidays": [
+//	    {"date": "12-29", "name": "年末年始休暇"},
+//	    {"date": "2025-04-01", "name": "創立記念日"}
+//	  ],
+//	  "working_days": [
+//	    {"date": "2025-06-14", "name": "出勤日"}
+//	  ]
+//	}
+func ParseOverlayJSON(r io.Reader) (*Overlay, error) {
+	dec := json.NewDecoder(r)
+	dec.DisallowUnknownFields()
+
+	var o Overlay
+	if err := dec.Decode(&o); err != nil {
+		return nil, fmt.Errorf("holiday: failed to parse overlay: %w", err)
+	}
+	if err := o.validate(); err != nil {
+		return nil, err
+	}
+	return &o, nil
+}
+
+// ParseOverlayYAML parses an overlay in the YAML format.
+//
+//	holidays:
+//	  - date: "12-29"
+//	    name: 年末年始休暇
+//	  - date: "2025-04-01"
+//	    name: 創立記念日
+//	working_days:
+//	  - date: "2025-06-14"
+//	    name: 出勤日
+func ParseOverlayYAML(r io.Reader) (*Overlay, error) {
+	dec := yaml.NewDecoder(r)
+	dec.KnownFields(true)
+
+	var o Overlay
+	if err := dec.Decode(&o); err != nil {
+		return nil, fmt.Errorf("holiday: failed to parse overlay: %w", err)
+	}
+	if err := o.validate(); err != nil {
+		return nil, err
+	}
+	return &o, nil
+}
+
+// ParseOverlayCSV parses an overlay in the CSV format.
+// Each record has three fields: the type ("holiday" or "working"), the date and the name.
+// The header line "type,date,name" is optional.
+//
+//	type,date,name
+//	holiday,12-29,年末年始休暇
+//	holiday,2025-04-01,創立記念日
+//	working,2025-06-14,出勤日
+func ParseOverlayCSV(r io.Reader) (*Overlay, error) {
+	csvReader := csv.NewReader(r)
+	csvReader.FieldsPerRecord = 3
+	csvReader.TrimLeadingSpace = true
+
+	var o Overlay
+	for i := 0; ; i++ {
+		record, err := csvReader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("holiday: failed to parse overlay: %w", err)
+		}
+		if i == 0 && record[0] == "type" {
+			// skip the header
+			continue
+		}
+
+		d := OverlayDate{
+			Date: record[1],
+			Name: record[2],
+		}
+		switch record[0] {
+		case "holiday":
+			o.Holidays = append(o.Holidays, d)
+		case "working":
+			o.WorkingDays = append(o.WorkingDays, d)
+		default:
+			return nil, fmt.Errorf("holiday: failed to parse overlay: unknown type %q", record[0])
+		}
+	}
+	if err := o.validate(); err != nil {
+		return nil, err
+	}
+	return &o, nil
+}
+
+// LoadOverlayFile loads an overlay from the file.
+// The format is determined by the extension of the file name: ".json", ".yaml", ".yml" or ".csv".
+func LoadOverlayFile(name string) (*Overlay, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	switch ext := strings.ToLower(filepath.Ext(name)); ext {
+	case ".json":
+		return ParseOverlayJSON(f)
+	case ".yaml", ".yml":
+		return ParseOverlayYAML(f)
+	case ".csv":
+		return ParseOverlayCSV(f)
+	default:
+		return nil, fmt.Errorf("holiday: unknown overlay format: %q", ext)
+	}
+}
+
+func (o *Overlay) validate() error {
+	for _, d := range o.Holidays {
+		if _, err := parseOverlayDate(d); err != nil {
+			return err
+		}
+	}
+	for _, d := range o.WorkingDays {
+		if _, err := parseOverlayDate(d); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// overlayDate is a parsed OverlayDate.
+type overlayDate struct {
+	// Year is zero if the date repeats every year.
+	Year  int
+	Month time.Month
+	Day   int
+	Name  string
+}
+
+func parseOverlayDate(d OverlayDate) (overlayDate, error) {
+	if len(d.Date) == len("01-02") {
+		// the date repeats every year.
+		// use a leap year for validation to accept February 29.
+		date, err := ParseDate("2000-" + d.Date)
+		if err != nil || !date.isValid() {
+			return overlayDate{}, fmt.Errorf("holiday: invalid overlay date: %q", d.Date)
+		}
+		return overlayDate{
+			Month: date.Month,
+			Day:   date.Day,
+			Name:  d.Name,
+		}, nil
+	}
+
+	date, err := ParseDate(d.Date)
+	if err != nil || !date.isValid() {
+		return overlayDate{}, fmt.Errorf("holiday: invalid overlay date: %q", d.Date)
+	}
+	return overlayDate{
+		Year:  date.Year,
+		Month: date.Month,
+		Day:   date.Day,
+		Name:  d.Name,
+	}, nil
+}
+
+// in returns the date in the year and the month.
+// It returns false if the date is not in the month.
+func (d overlayDate) in(year int, month time.Month) (Date, bool) {
+	if d.Month != month || (d.Year != 0 && d.Year != year) {
+		return Date{}, false
+	}
+	date := Date{year, month, d.Day}
+	if !date.isValid() {
+		// February 29 in a common year
+		return Date{}, false
+	}
+	return date, true
+}
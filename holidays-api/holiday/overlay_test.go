@@ -0,0 +1,159 @@
+package holiday
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseOverlayJSON(t *testing.T) {
+	input := `{
+  "holidays": [
+    {"date": "12-29", "name": "年末年始休暇"},
+    {"date": "2025-04-01", "name": "創立記念日"}
+  ],
+  "working_days": [
+    {"date": "2025-06-14", "name": "出勤日"}
+  ]
+}`
+	got, err := ParseOverlayJSON(strings.NewReader(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &Overlay{
+		Holidays: []OverlayDate{
+			{Date: "12-29", Name: "年末年始休暇"},
+			{Date: "2025-04-01", Name: "創立記念日"},
+		},
+		WorkingDays: []OverlayDate{
+			{Date: "2025-06-14", Name: "出勤日"},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("want %v, got %v", want, got)
+	}
+}
+
+func TestParseOverlayJSON_Invalid(t *testing.T) {
+	tests := []string{
+		`{"holidays": [{"date": "2025-02-29", "name": "invalid"}]}`,
+		`{"holidays": [{"date": "13-01", "name": "invalid"}]}`,
+		`{"unknown": []}`,
+		`{`,
+	}
+	for _, input := range tests {
+		if _, err := ParseOverlayJSON(strings.NewReader(input)); err == nil {
+			t.Errorf("ParseOverlayJSON(%q): want error, got nil", input)
+		}
+	}
+}
+
+func TestParseOverlayYAML(t *testing.T) {
+	input := `holidays:
+  - date: "12-29"
+    name: 年末年始休暇
+  - date: "2025-04-01"
+    name: 創立記念日
+working_days:
+  - date: "2025-06-14"
+    name: 出勤日
+`
+	got, err := ParseOverlayYAML(strings.NewReader(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &Overlay{
+		Holidays: []OverlayDate{
+			{Date: "12-29", Name: "年末年始休暇"},
+			{Date: "2025-04-01", Name: "創立記念日"},
+		},
+		WorkingDays: []OverlayDate{
+			{Date: "2025-06-14", Name: "出勤日"},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("want %v, got %v", want, got)
+	}
+}
+
+func TestParseOverlayYAML_Invalid(t *testing.T) {
+	tests := []string{
+		"holidays:\n  - date: \"2025-02-29\"\n    name: invalid\n",
+		"unknown: []\n",
+	}
+	for _, input := range tests {
+		if _, err := ParseOverlayYAML(strings.NewReader(input)); err == nil {
+			t.Errorf("ParseOverlayYAML(%q): want error, got nil", input)
+		}
+	}
+}
+
+func TestLoadOverlayFile(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"overlay.json": `{"holidays": [{"date": "12-29", "name": "年末年始休暇"}]}`,
+		"overlay.yaml": "holidays:\n  - date: \"12-29\"\n    name: 年末年始休暇\n",
+		"overlay.csv":  "holiday,12-29,年末年始休暇\n",
+	}
+	want := &Overlay{
+		Holidays: []OverlayDate{
+			{Date: "12-29", Name: "年末年始休暇"},
+		},
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		got, err := LoadOverlayFile(path)
+		if err != nil {
+			t.Errorf("%s: %v", name, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("%s: want %v, got %v", name, want, got)
+		}
+	}
+
+	if _, err := LoadOverlayFile(filepath.Join(dir, "overlay.txt")); err == nil {
+		t.Error("overlay.txt: want error, got nil")
+	}
+}
+
+func TestParseOverlayCSV(t *testing.T) {
+	input := "type,date,name\n" +
+		"holiday,12-29,年末年始休暇\n" +
+		"holiday, 2025-04-01, 創立記念日\n" +
+		"working,2025-06-14,出勤日\n"
+	got, err := ParseOverlayCSV(strings.NewReader(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &Overlay{
+		Holidays: []OverlayDate{
+			{Date: "12-29", Name: "年末年始休暇"},
+			{Date: "2025-04-01", Name: "創立記念日"},
+		},
+		WorkingDays: []OverlayDate{
+			{Date: "2025-06-14", Name: "出勤日"},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("want %v, got %v", want, got)
+	}
+}
+
+func TestParseOverlayCSV_Invalid(t *testing.T) {
+	tests := []string{
+		"vacation,12-29,年末年始休暇\n",
+		"holiday,12-32,invalid\n",
+		"holiday,12-29\n",
+	}
+	for _, input := range tests {
+		if _, err := ParseOverlayCSV(strings.NewReader(input)); err == nil {
+			t.Errorf("ParseOverlayCSV(%q): want error, got nil", input)
+		}
+	}
+}
@@ -0,0 +1,213 @@
+package holiday
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// EndOfMonth is the day of PaydayRule that means the last day of the month (末日).
+const EndOfMonth = 31
+
+// PaydayRule is a rule of paydays or due dates (給料日・支払期日), such as
+// "25日、休日の場合は前営業日", "月末締め翌月末払い" and "五十日".
+type PaydayRule struct {
+	// ClosingDay is the closing day (締め日) of the month.
+	// It is zero if the rule has no closing day.
+	ClosingDay int
+
+	// MonthOffset is the number of months from the closing month to the payment month.
+	// e.g. 1 for 翌月払い.
+	MonthOffset int
+
+	// Days are the days of the month to pay.
+	// If the month doesn't have the day, the last day of the month is used.
+	Days []int
+
+	// Convention is the convention to adjust the days that are not business days.
+	Convention BusinessDayConvention
+}
+
+// Payday is a date that is evaluated from a PaydayRule.
+type Payday struct {
+	// Date is the date to pay.
+	Date Date
+
+	// Closing is the closing date (締め日) of the payment.
+	// It is the zero value if the rule has no closing day.
+	Closing Date
+}
+
+var errInvalidPaydayRule = errors.New("holiday: invalid payday rule")
+
+// gotoDays are the days of 五十日 (ごとおび).
+var gotoDays = []int{5, 10, 15, 20, 25, EndOfMonth}
+
+var monthOffsets = []struct {
+	prefix string
+	offset int
+}{
+	{"当月", 0},
+	{"翌々月", 2},
+	{"翌月", 1},
+}
+
+// ParsePaydayRule parses a rule of paydays in Japanese. It accepts:
+//
+//   - the day of the month: "25日", "末日" or "月末"
+//   - the closing day and the payment day: "月末締め翌月末払い" or "20日締め翌月10日払い"
+//   - 五十日 (ごとおび): the 5th, 10th, 15th, 20th, 25th and the last day of the month
+//
+// followed by the adjustment for holidays, such as "、休日の場合は前営業日" or "(土日祝は翌営業日)".
+// Full-width digits are also accepted.
+func ParsePaydayRule(s string) (*PaydayRule, error) {
+	s = toHalfWidthDigits(strings.TrimSpace(s))
+	s = strings.Replace(s, "締め、", "締め", 1) // 月末締め、翌月末払い
+	main, adjustment := s, ""
+	if i := strings.IndexAny(s, "、,(（"); i >= 0 {
+		main, adjustment = s[:i], s[i:]
+	}
+
+	r := &PaydayRule{}
+	switch {
+	case strings.Contains(adjustment, "前営業日"):
+		r.Convention = Preceding
+	case strings.Contains(adjustment, "翌営業日"):
+		r.Convention = Following
+	case adjustment != "":
+		return nil, fmt.Errorf("%w: unknown adjustment: %q", errInvalidPaydayRule, adjustment)
+	}
+
+	if main == "五十日" {
+		r.Days = slices.Clone(gotoDays)
+		return r, nil
+	}
+
+	if closing, payment, ok := strings.Cut(main, "締め"); ok {
+		day, err := parsePaydayDay(closing)
+		if err != nil {
+			return nil, err
+		}
+		r.ClosingDay = day
+
+		var found bool
+		for _, m := range monthOffsets {
+			if rest, ok := strings.CutPrefix(payment, m.prefix); ok {
+				r.MonthOffset, payment, found = m.offset, rest, true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("%w: unknown payment month: %q", errInvalidPaydayRule, payment)
+		}
+		main = payment
+	}
+
+	main = strings.TrimSuffix(main, "払い")
+	main = strings.TrimSuffix(main, "支")
+	day, err := parsePaydayDay(main)
+	if err != nil {
+		return nil, err
+	}
+	r.Days = []int{day}
+	return r, nil
+}
+
+// parsePaydayDay parses "25日", "末日", "月末" and "末".
+func parsePaydayDay(s string) (int, error) {
+	switch s {
+	case "末日", "月末", "末":
+		return EndOfMonth, nil
+	}
+	n, ok := strings.CutSuffix(s, "日")
+	if !ok {
+		return 0, fmt.Errorf("%w: invalid day: %q", errInvalidPaydayRule, s)
+	}
+	day, err := parseSmallInt(n)
+	if err != nil || day < 1 || day > 31 {
+		return 0, fmt.Errorf("%w: invalid day: %q", errInvalidPaydayRule, s)
+	}
+	return day, nil
+}
+
+// String returns the rule in Japanese. e.g. "月末締め翌月末払い、休日の場合は前営業日"
+func (r *PaydayRule) String() string {
+	var buf strings.Builder
+	if r.ClosingDay == 0 && slices.Equal(r.Days, gotoDays) {
+		buf.WriteString("五十日")
+	} else {
+		if r.ClosingDay != 0 {
+			writePaydayDay(&buf, r.ClosingDay)
+			buf.WriteString("締め")
+			for _, m := range monthOffsets {
+				if m.offset == r.MonthOffset {
+					buf.WriteString(m.prefix)
+				}
+			}
+		}
+		for i, day := range r.Days {
+			if i > 0 {
+				buf.WriteString("・")
+			}
+			if r.ClosingDay != 0 && day == EndOfMonth {
+				buf.WriteString("末")
+			} else {
+				writePaydayDay(&buf, day)
+			}
+		}
+		if r.ClosingDay != 0 {
+			buf.WriteString("払い")
+		}
+	}
+
+	switch r.Convention {
+	case Preceding, ModifiedPreceding:
+		buf.WriteString("、休日の場合は前営業日")
+	case Following, ModifiedFollowing:
+		buf.WriteString("、休日の場合は翌営業日")
+	}
+	return buf.String()
+}
+
+func writePaydayDay(buf *strings.Builder, day int) {
+	if day == EndOfMonth {
+		buf.WriteString("月末")
+		return
+	}
+	buf.WriteString(strconv.Itoa(day))
+	buf.WriteString("日")
+}
+
+// In returns the paydays in the month, adjusted by the calendar.
+// The closing dates are in the months before the payment month by MonthOffset.
+// The adjusted dates may be out of the month.
+func (r *PaydayRule) In(c *Calendar, year int, month time.Month) []Payday {
+	last := Date{year, month, 1}.lastDay()
+	var closing Date
+	if r.ClosingDay != 0 {
+		closingMonth := FromTime(time.Date(year, month-time.Month(r.MonthOffset), 1, 0, 0, 0, 0, time.UTC)).lastDay()
+		closing = Date{closingMonth.Year, closingMonth.Month, min(r.ClosingDay, closingMonth.Day)}
+	}
+
+	ret := make([]Payday, 0, len(r.Days))
+	for _, day := range r.Days {
+		d := Date{year, month, min(day, last.Day)}
+		ret = append(ret, Payday{
+			Date:    c.Adjust(d, r.Convention),
+			Closing: closing,
+		})
+	}
+	return ret
+}
+
+// InYear returns the paydays in the months of the year, adjusted by the calendar.
+func (r *PaydayRule) InYear(c *Calendar, year int) []Payday {
+	var ret []Payday
+	for month := time.January; month <= time.December; month++ {
+		ret = append(ret, r.In(c, year, month)...)
+	}
+	return ret
+}
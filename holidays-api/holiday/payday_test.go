@@ -0,0 +1,112 @@
+package holiday
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestParsePaydayRule(t *testing.T) {
+	tests := []struct {
+		input  string
+		want   PaydayRule
+		output string
+	}{
+		{
+			input:  "25日",
+			want:   PaydayRule{Days: []int{25}},
+			output: "25日",
+		},
+		{
+			input:  "２５日、休日の場合は前営業日",
+			want:   PaydayRule{Days: []int{25}, Convention: Preceding},
+			output: "25日、休日の場合は前営業日",
+		},
+		{
+			input:  "末日(土日祝は翌営業日)",
+			want:   PaydayRule{Days: []int{EndOfMonth}, Convention: Following},
+			output: "月末、休日の場合は翌営業日",
+		},
+		{
+			input:  "月末締め翌月末払い",
+			want:   PaydayRule{ClosingDay: EndOfMonth, MonthOffset: 1, Days: []int{EndOfMonth}},
+			output: "月末締め翌月末払い",
+		},
+		{
+			input:  "20日締め、翌々月10日支払い、休日の場合は前営業日",
+			want:   PaydayRule{ClosingDay: 20, MonthOffset: 2, Days: []int{10}, Convention: Preceding},
+			output: "20日締め翌々月10日払い、休日の場合は前営業日",
+		},
+		{
+			input:  "五十日",
+			want:   PaydayRule{Days: []int{5, 10, 15, 20, 25, EndOfMonth}},
+			output: "五十日",
+		},
+	}
+	for _, tt := range tests {
+		got, err := ParsePaydayRule(tt.input)
+		if err != nil {
+			t.Errorf("%q: unexpected error: %v", tt.input, err)
+			continue
+		}
+		if got.ClosingDay != tt.want.ClosingDay || got.MonthOffset != tt.want.MonthOffset ||
+			!slices.Equal(got.Days, tt.want.Days) || got.Convention != tt.want.Convention {
+			t.Errorf("%q: want %+v, got %+v", tt.input, tt.want, *got)
+		}
+		if got.String() != tt.output {
+			t.Errorf("%q: want %q, got %q", tt.input, tt.output, got.String())
+		}
+	}
+
+	for _, input := range []string{"", "32日", "25", "月末締め", "月末締め来月末払い", "25日、休日の場合は休み"} {
+		if _, err := ParsePaydayRule(input); err == nil {
+			t.Errorf("%q: want error, got nil", input)
+		}
+	}
+}
+
+func TestPaydayRule_In(t *testing.T) {
+	bank := Bank()
+
+	// 2025-05-25 is Sunday.
+	r, _ := ParsePaydayRule("25日、休日の場合は前営業日")
+	got := r.In(bank, 2025, time.May)
+	if len(got) != 1 || got[0].Date != (Date{2025, time.May, 23}) || got[0].Closing != (Date{}) {
+		t.Errorf("unexpected paydays: %v", got)
+	}
+
+	// 2025-05-31 is Saturday, and the closing date is the end of April.
+	r, _ = ParsePaydayRule("月末締め翌月末払い、休日の場合は前営業日")
+	got = r.In(bank, 2025, time.May)
+	if len(got) != 1 || got[0].Date != (Date{2025, time.May, 30}) || got[0].Closing != (Date{2025, time.April, 30}) {
+		t.Errorf("unexpected paydays: %v", got)
+	}
+
+	// the closing date is in the previous year.
+	got = r.In(bank, 2025, time.January)
+	if len(got) != 1 || got[0].Date != (Date{2025, time.January, 31}) || got[0].Closing != (Date{2024, time.December, 31}) {
+		t.Errorf("unexpected paydays: %v", got)
+	}
+
+	// 2025-08-10 is Sunday, and 2025-08-11 is 山の日.
+	r, _ = ParsePaydayRule("五十日、休日の場合は翌営業日")
+	var dates []Date
+	for _, p := range r.In(bank, 2025, time.August) {
+		dates = append(dates, p.Date)
+	}
+	want := []Date{
+		{2025, time.August, 5},
+		{2025, time.August, 12},
+		{2025, time.August, 15},
+		{2025, time.August, 20},
+		{2025, time.August, 25},
+		{2025, time.September, 1},
+	}
+	if !slices.Equal(dates, want) {
+		t.Errorf("want %v, got %v", want, dates)
+	}
+
+	if got := r.InYear(bank, 2025); len(got) != 12*6 {
+		t.Errorf("want %d paydays, got %d", 12*6, len(got))
+	}
+}
@@ -0,0 +1,65 @@
+package holiday
+
+import "strconv"
+
+// Rokuyo is one of the six days of the lunisolar calendar (六曜).
+// The value is the order in the cycle that starts from 先勝 on the first day of the first month.
+type Rokuyo int
+
+const (
+	RokuyoSensho     Rokuyo = iota // 先勝
+	RokuyoTomobiki                 // 友引
+	RokuyoSenbu                    // 先負
+	RokuyoButsumetsu               // 仏滅
+	RokuyoTaian                    // 大安
+	RokuyoShakko                   // 赤口
+)
+
+// the number of the rokuyo.
+const rokuyoCount = 6
+
+var rokuyoNames = [rokuyoCount]seasonName{
+	RokuyoSensho:     {"先勝", translation{"Sensho", "Senshō"}},
+	RokuyoTomobiki:   {"友引", translation{"Tomobiki", "Tomobiki"}},
+	RokuyoSenbu:      {"先負", translation{"Senbu", "Senbu"}},
+	RokuyoButsumetsu: {"仏滅", translation{"Butsumetsu", "Butsumetsu"}},
+	RokuyoTaian:      {"大安", translation{"Taian", "Taian"}},
+	RokuyoShakko:     {"赤口", translation{"Shakko", "Shakkō"}},
+}
+
+func (r Rokuyo) valid() bool {
+	return 0 <= r && r < rokuyoCount
+}
+
+// String returns the name of the rokuyo in Japanese. e.g. "大安"
+func (r Rokuyo) String() string {
+	if !r.valid() {
+		return "Rokuyo(" + strconv.Itoa(int(r)) + ")"
+	}
+	return rokuyoNames[r].Japanese
+}
+
+// LocalizedName returns the name of the rokuyo in the language.
+func (r Rokuyo) LocalizedName(lang Language) string {
+	if !r.valid() {
+		return r.String()
+	}
+	switch lang {
+	case English:
+		return rokuyoNames[r].English
+	case Romaji:
+		return rokuyoNames[r].Romaji
+	}
+	return rokuyoNames[r].Japanese
+}
+
+// Rokuyo returns the rokuyo of the date in the lunisolar calendar.
+// It is determined by the sum of the month and the day; a leap month is treated as the same month.
+func (ld LunisolarDate) Rokuyo() Rokuyo {
+	return Rokuyo((ld.Month + ld.Day + 4) % rokuyoCount)
+}
+
+// Rokuyo returns the rokuyo (六曜) of d.
+func (d Date) Rokuyo() Rokuyo {
+	return d.Lunisolar().Rokuyo()
+}
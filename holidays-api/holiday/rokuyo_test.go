@@ -0,0 +1,42 @@
+package holiday
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDate_Rokuyo(t *testing.T) {
+	tests := []struct {
+		date Date
+		want Rokuyo
+	}{
+		// the first day of each month is fixed.
+		{Date{2025, time.January, 29}, RokuyoSensho},    // 1月1日
+		{Date{2025, time.February, 28}, RokuyoTomobiki}, // 2月1日
+		{Date{2025, time.March, 29}, RokuyoSenbu},       // 3月1日
+		{Date{2025, time.April, 28}, RokuyoButsumetsu},  // 4月1日
+		{Date{2025, time.May, 27}, RokuyoTaian},         // 5月1日
+		{Date{2025, time.June, 25}, RokuyoShakko},       // 6月1日
+		{Date{2025, time.July, 25}, RokuyoShakko},       // 閏6月1日
+
+		{Date{2025, time.January, 1}, RokuyoSensho}, // 2024年12月2日
+		{Date{2025, time.January, 30}, RokuyoTomobiki},
+	}
+	for _, tt := range tests {
+		if got := tt.date.Rokuyo(); got != tt.want {
+			t.Errorf("%s: want %s, got %s", tt.date, tt.want, got)
+		}
+	}
+}
+
+func TestRokuyo_LocalizedName(t *testing.T) {
+	if got, want := RokuyoTaian.LocalizedName(Japanese), "大安"; got != want {
+		t.Errorf("want %q, got %q", want, got)
+	}
+	if got, want := RokuyoShakko.LocalizedName(Romaji), "Shakkō"; got != want {
+		t.Errorf("want %q, got %q", want, got)
+	}
+	if got, want := Rokuyo(6).String(), "Rokuyo(6)"; got != want {
+		t.Errorf("want %q, got %q", want, got)
+	}
+}
@@ -0,0 +1,105 @@
+package holiday
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// BusinessDayConvention is a convention to adjust a date that is not a business day,
+// defined by the 2006 ISDA Definitions.
+type BusinessDayConvention int
+
+const (
+	// Unadjusted doesn't adjust the date.
+	Unadjusted BusinessDayConvention = iota
+
+	// Following adjusts the date to the first following business day.
+	Following
+
+	// ModifiedFollowing adjusts the date to the first following business day,
+	// unless it falls in the next month, in which case it adjusts to the first preceding business day.
+	ModifiedFollowing
+
+	// Preceding adjusts the date to the first preceding business day.
+	Preceding
+
+	// ModifiedPreceding adjusts the date to the first preceding business day,
+	// unless it falls in the previous month, in which case it adjusts to the first following business day.
+	ModifiedPreceding
+)
+
+var businessDayConventionNames = [...]string{
+	Unadjusted:        "Unadjusted",
+	Following:         "Following",
+	ModifiedFollowing: "ModifiedFollowing",
+	Preceding:         "Preceding",
+	ModifiedPreceding: "ModifiedPreceding",
+}
+
+func (conv BusinessDayConvention) String() string {
+	if 0 <= conv && int(conv) < len(businessDayConventionNames) {
+		return businessDayConventionNames[conv]
+	}
+	return "BusinessDayConvention(" + strconv.Itoa(int(conv)) + ")"
+}
+
+// ParseBusinessDayConvention parses the name of a business day convention, such as "ModifiedFollowing".
+func ParseBusinessDayConvention(s string) (BusinessDayConvention, error) {
+	for conv, name := range businessDayConventionNames {
+		if s == name {
+			return BusinessDayConvention(conv), nil
+		}
+	}
+	return 0, fmt.Errorf("holiday: unknown business day convention: %q", s)
+}
+
+// Adjust adjusts d to a business day by the convention.
+func Adjust(d Date, conv BusinessDayConvention) Date {
+	return national.Adjust(d, conv)
+}
+
+// AddMonths returns the date n months after d, adjusted by the convention.
+// See [Calendar.AddMonths] for details.
+func AddMonths(d Date, n int, conv BusinessDayConvention, eom bool) Date {
+	return national.AddMonths(d, n, conv, eom)
+}
+
+// Adjust adjusts d to a business day in the calendar by the convention.
+func (c *Calendar) Adjust(d Date, conv BusinessDayConvention) Date {
+	switch conv {
+	case Following:
+		return c.BusinessDayOnOrAfter(d)
+	case ModifiedFollowing:
+		ret := c.BusinessDayOnOrAfter(d)
+		if ret.Month != d.Month {
+			ret = c.BusinessDayOnOrBefore(d)
+		}
+		return ret
+	case Preceding:
+		return c.BusinessDayOnOrBefore(d)
+	case ModifiedPreceding:
+		ret := c.BusinessDayOnOrBefore(d)
+		if ret.Month != d.Month {
+			ret = c.BusinessDayOnOrAfter(d)
+		}
+		return ret
+	}
+	return d
+}
+
+// AddMonths returns the date n months after d, adjusted by the convention in the calendar.
+// If the month doesn't have the day of d, the last day of the month is used.
+//
+// If eom is true, the end-of-month rule is applied:
+// when d is the last business day of the month, the result is the last business day of the month.
+func (c *Calendar) AddMonths(d Date, n int, conv BusinessDayConvention, eom bool) Date {
+	// the last day of the month n months after d
+	t := time.Date(d.Year, d.Month+time.Month(n), 1, 0, 0, 0, 0, time.UTC)
+	last := FromTime(t).lastDay()
+
+	if eom && d == c.BusinessDayOnOrBefore(d.lastDay()) {
+		return c.BusinessDayOnOrBefore(last)
+	}
+	return c.Adjust(Date{last.Year, last.Month, min(d.Day, last.Day)}, conv)
+}
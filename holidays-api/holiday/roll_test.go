@@ -0,0 +1,91 @@
+package holiday
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAdjust(t *testing.T) {
+	tests := []struct {
+		date Date
+		conv BusinessDayConvention
+		want Date
+	}{
+		// 2025-05-30 is Friday, and 2025-05-31 is Saturday.
+		{Date{2025, time.May, 31}, Unadjusted, Date{2025, time.May, 31}},
+		{Date{2025, time.May, 31}, Following, Date{2025, time.June, 2}},
+		{Date{2025, time.May, 31}, ModifiedFollowing, Date{2025, time.May, 30}},
+		{Date{2025, time.May, 31}, Preceding, Date{2025, time.May, 30}},
+		{Date{2025, time.May, 31}, ModifiedPreceding, Date{2025, time.May, 30}},
+
+		// 2025-03-01 is Saturday.
+		{Date{2025, time.March, 1}, Following, Date{2025, time.March, 3}},
+		{Date{2025, time.March, 1}, ModifiedFollowing, Date{2025, time.March, 3}},
+		{Date{2025, time.March, 1}, Preceding, Date{2025, time.February, 28}},
+		{Date{2025, time.March, 1}, ModifiedPreceding, Date{2025, time.March, 3}},
+
+		// Golden Week
+		{Date{2025, time.May, 3}, Following, Date{2025, time.May, 7}},
+		{Date{2025, time.May, 6}, Preceding, Date{2025, time.May, 2}},
+
+		// business days are not adjusted.
+		{Date{2025, time.May, 7}, ModifiedFollowing, Date{2025, time.May, 7}},
+	}
+	for _, tt := range tests {
+		if got := Adjust(tt.date, tt.conv); got != tt.want {
+			t.Errorf("%s, %s: want %s, got %s", tt.date, tt.conv, tt.want, got)
+		}
+	}
+}
+
+func TestAddMonths(t *testing.T) {
+	tests := []struct {
+		date Date
+		n    int
+		conv BusinessDayConvention
+		eom  bool
+		want Date
+	}{
+		{Date{2025, time.January, 15}, 12, ModifiedFollowing, false, Date{2026, time.January, 15}},
+		{Date{2025, time.January, 31}, 1, ModifiedFollowing, false, Date{2025, time.February, 28}},
+		{Date{2025, time.March, 31}, -1, ModifiedFollowing, false, Date{2025, time.February, 28}},
+
+		// 2025-02-28 is the last business day of February.
+		{Date{2025, time.February, 28}, 1, ModifiedFollowing, false, Date{2025, time.March, 28}},
+		{Date{2025, time.February, 28}, 1, ModifiedFollowing, true, Date{2025, time.March, 31}},
+
+		// 2025-05-31 is Saturday.
+		{Date{2025, time.April, 30}, 1, ModifiedFollowing, true, Date{2025, time.May, 30}},
+		{Date{2025, time.April, 30}, 1, Following, false, Date{2025, time.May, 30}},
+
+		// 2025-11-28 is the last business day of November, because 2025-11-30 is Sunday.
+		{Date{2025, time.November, 28}, 1, ModifiedFollowing, true, Date{2025, time.December, 31}},
+		{Date{2025, time.November, 28}, 1, ModifiedFollowing, false, Date{2025, time.December, 29}},
+	}
+	for _, tt := range tests {
+		if got := AddMonths(tt.date, tt.n, tt.conv, tt.eom); got != tt.want {
+			t.Errorf("%s, %d, %s, %t: want %s, got %s", tt.date, tt.n, tt.conv, tt.eom, tt.want, got)
+		}
+	}
+
+	// December 31 is a bank holiday.
+	if got, want := Bank().AddMonths(Date{2025, time.November, 28}, 1, ModifiedFollowing, true), (Date{2025, time.December, 30}); got != want {
+		t.Errorf("want %s, got %s", want, got)
+	}
+}
+
+func TestParseBusinessDayConvention(t *testing.T) {
+	for conv := Unadjusted; conv <= ModifiedPreceding; conv++ {
+		got, err := ParseBusinessDayConvention(conv.String())
+		if err != nil {
+			t.Errorf("%s: %v", conv, err)
+			continue
+		}
+		if got != conv {
+			t.Errorf("want %s, got %s", conv, got)
+		}
+	}
+	if _, err := ParseBusinessDayConvention("Nearest"); err == nil {
+		t.Error("want error, got nil")
+	}
+}
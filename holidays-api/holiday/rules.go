@@ -0,0 +1,684 @@
+package holiday
+
+import (
+	"bytes"
+	_ "embed"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+	"unicode"
+
+	"gopkg.in/yaml.v3"
+)
+
+// rules.yaml is the rules of the national holidays defined by the laws.
+// See the comments in the file for the format.
+//
+//go:embed rules.yaml
+var rulesYAML []byte
+
+// RuleSet is a set of the rules of the national holidays defined by the laws.
+// The holidays out of the year range of the Dataset are calculated based on the rules.
+type RuleSet struct {
+	// inLieu is the policies of the holidays in lieu.
+	inLieu inLieuRules
+
+	// rules are the rules in descending order of BeginYear.
+	// They are expanded from amendments.
+	rules []annuallyHolidaysRule
+
+	// special are the one-off holidays.
+	special []specialHoliday
+
+	// amendments are the amendments in the rules file, that rules are expanded from.
+	// They are used to calculate the rules known at a time. see AsOf.
+	amendments []ruleAmendment
+
+	// laws are the laws in the rules file, keyed by the number.
+	laws map[string]lawEntry
+
+	// established are the years that the laws adding the holidays were published, keyed by the identifier.
+	established map[string]int
+}
+
+// ruleSetFile is the format of the rules file.
+type ruleSetFile struct {
+	Laws    []lawEntry       `yaml:"laws"`
+	InLieu  inLieuRules      `yaml:"in_lieu"`
+	Special []specialHoliday `yaml:"special"`
+	Rules   []ruleAmendment  `yaml:"rules"`
+}
+
+// lawEntry is a law that is cited by the number in the rules file.
+type lawEntry struct {
+	Law `yaml:",inline"`
+
+	// Published is the date that the law was published (公布) in the "2006-01-02" format.
+	Published string `yaml:"published"`
+}
+
+// annuallyHolidaysRule is the holidays in the years from BeginYear to the next rule,
+// that are merged from the amendments applying to the years.
+type annuallyHolidaysRule struct {
+	// BeginYear is a year that the law is enforced
+	BeginYear int
+
+	// Laws are the laws that define the rule, in the order of the amendments.
+	Laws []Law
+
+	// StaticHolydays are holydays that are on the same date every year
+	StaticHolydays []staticHolyday
+
+	// StaticHolydays are holydays that are on the same weekday in the month.
+	WeekdayHolydays []weekdayHolyday
+
+	// EquinoxHolydays are holydays that are on the equinox days.
+	EquinoxHolydays []equinoxHolyday
+}
+
+type staticHolyday struct {
+	Date string `yaml:"date"` // MM-DD
+	ID   string `yaml:"id"`
+	Name string `yaml:"name"`
+	Kana string `yaml:"kana"`
+
+	// Purpose is the purpose (趣旨) of the holiday defined by the law.
+	Purpose string `yaml:"purpose"`
+
+	// Laws are the laws that set the holiday: the National Holiday Law and the amendments that added, moved or renamed it.
+	// They are resolved from LawNumbers.
+	Laws       []Law    `yaml:"-"`
+	LawNumbers []string `yaml:"laws"`
+}
+
+type weekdayHolyday struct {
+	Month time.Month `yaml:"month"`
+
+	// Weekday is parsed from WeekdayName.
+	Weekday     time.Weekday `yaml:"-"`
+	WeekdayName string       `yaml:"weekday"`
+
+	// Nth is the index of the weekday in the month, starting from 1.
+	Nth int `yaml:"nth"`
+
+	ID   string `yaml:"id"`
+	Name string `yaml:"name"`
+	Kana string `yaml:"kana"`
+
+	// Purpose is the purpose (趣旨) of the holiday defined by the law.
+	Purpose string `yaml:"purpose"`
+
+	// Laws are the laws that set the holiday: the National Holiday Law and the amendments that added, moved or renamed it.
+	// They are resolved from LawNumbers.
+	Laws       []Law    `yaml:"-"`
+	LawNumbers []string `yaml:"laws"`
+}
+
+// the kinds of the equinox days.
+const (
+	equinoxVernal   = "vernal"
+	equinoxAutumnal = "autumnal"
+)
+
+type equinoxHolyday struct {
+	// Equinox is "vernal" or "autumnal".
+	Equinox string `yaml:"equinox"`
+
+	ID   string `yaml:"id"`
+	Name string `yaml:"name"`
+	Kana string `yaml:"kana"`
+
+	// Purpose is the purpose (趣旨) of the holiday defined by the law.
+	Purpose string `yaml:"purpose"`
+
+	// Laws are the laws that set the holiday: the National Holiday Law and the amendments that added, moved or renamed it.
+	// They are resolved from LawNumbers.
+	Laws       []Law    `yaml:"-"`
+	LawNumbers []string `yaml:"laws"`
+}
+
+type inLieuRules struct {
+	// Substitute are the policies of the substitute holidays (振替休日)
+	// in ascending order of Effective.
+	Substitute []substitutePolicy `yaml:"substitute"`
+
+	// Citizens are the policies of the citizens' holidays (国民の休日)
+	// in ascending order of Effective.
+	Citizens []citizensPolicy `yaml:"citizens"`
+}
+
+// the rules of the substitute holidays.
+const (
+	// the next day of the national holiday on Sunday is a holiday, unless it is a national holiday.
+	substituteNextDay = "next-day"
+
+	// the nearest day after the national holiday on Sunday that is not a national holiday is a holiday.
+	substituteNearestNonHoliday = "nearest-non-holiday"
+)
+
+type substitutePolicy struct {
+	// Effective is the date in the "2006-01-02" format.
+	// The policy applies to the national holidays on or after the date.
+	Effective string `yaml:"effective"`
+
+	// Rule is substituteNextDay or substituteNearestNonHoliday.
+	Rule string `yaml:"rule"`
+
+	// Laws are the laws that define the policy.
+	// They are resolved from LawNumbers.
+	Laws       []Law    `yaml:"-"`
+	LawNumbers []string `yaml:"laws"`
+}
+
+type citizensPolicy struct {
+	// Effective is the date in the "2006-01-02" format.
+	// A day sandwiched between national holidays on or after the date is a holiday.
+	Effective string `yaml:"effective"`
+
+	// Laws are the laws that define the policy.
+	// They are resolved from LawNumbers.
+	Laws       []Law    `yaml:"-"`
+	LawNumbers []string `yaml:"laws"`
+}
+
+// specialHoliday is a one-off holiday defined by a special law.
+type specialHoliday struct {
+	// Date is the date in the "2006-01-02" format.
+	Date string `yaml:"date"`
+
+	// Kind is KindSpecial or KindImperialCeremony.
+	// It is parsed from KindName.
+	Kind     Kind   `yaml:"-"`
+	KindName string `yaml:"kind"`
+
+	ID   string `yaml:"id"`
+	Name string `yaml:"name"`
+	Kana string `yaml:"kana"`
+
+	// Laws are the laws that define the holiday.
+	// They are resolved from LawNumbers.
+	Laws       []Law    `yaml:"-"`
+	LawNumbers []string `yaml:"laws"`
+}
+
+func (d *specialHoliday) holiday() Holiday {
+	return Holiday{
+		Date: d.Date,
+		Name: d.Name,
+		Kind: d.Kind,
+		ID:   d.ID,
+		Kana: d.Kana,
+	}
+}
+
+// the rule set that is embedded in the package.
+var defaultRuleSet = mustParseRuleSet(rulesYAML)
+
+// the rule set that is used by the calculation.
+// nil means defaultRuleSet.
+var currentRuleSet atomic.Pointer[RuleSet]
+
+// DefaultRuleSet returns the rule set bundled in the package.
+func DefaultRuleSet() *RuleSet {
+	return defaultRuleSet
+}
+
+// CurrentRuleSet returns the rule set that is currently used.
+func CurrentRuleSet() *RuleSet {
+	return loadRuleSet()
+}
+
+// SetRuleSet replaces the rule set that is used to calculate the holidays out of the year range of the dataset.
+// It is safe to call SetRuleSet while other goroutines are looking up holidays.
+// If rs is nil, it restores the rule set bundled in the package.
+func SetRuleSet(rs *RuleSet) {
+	currentRuleSet.Store(rs)
+}
+
+func loadRuleSet() *RuleSet {
+	if rs := currentRuleSet.Load(); rs != nil {
+		return rs
+	}
+	return defaultRuleSet
+}
+
+func mustParseRuleSet(data []byte) *RuleSet {
+	rs, err := ParseRuleSet(bytes.NewReader(data))
+	if err != nil {
+		panic(err)
+	}
+	return rs
+}
+
+// ParseRuleSet parses the rules of the national holidays in the YAML format, and validates them.
+// See rules.yaml in the package for the format.
+func ParseRuleSet(r io.Reader) (*RuleSet, error) {
+	dec := yaml.NewDecoder(r)
+	dec.KnownFields(true)
+
+	var f ruleSetFile
+	if err := dec.Decode(&f); err != nil {
+		return nil, fmt.Errorf("holiday: failed to parse rules: %w", err)
+	}
+	return newRuleSet(&f)
+}
+
+// newRuleSet resolves the laws cited in the rules file, and expands the amendments into the rules.
+func newRuleSet(f *ruleSetFile) (*RuleSet, error) {
+	var errs []error
+	report := func(format string, args ...any) {
+		errs = append(errs, fmt.Errorf("holiday: invalid rules: "+format, args...))
+	}
+
+	laws := make(map[string]lawEntry, len(f.Laws))
+	for _, law := range f.Laws {
+		if err := validateLaw(law.Law); err != nil {
+			report("law %q: %w", law.Number, err)
+		}
+		if err := validateEffective(law.Published); err != nil {
+			report("law %q: invalid published date", law.Number)
+		}
+		if _, ok := laws[law.Number]; ok {
+			report("law %q: duplicated", law.Number)
+		}
+		laws[law.Number] = law
+	}
+	resolve := func(numbers []string) ([]Law, error) {
+		ret := make([]Law, 0, len(numbers))
+		for _, number := range numbers {
+			law, ok := laws[number]
+			if !ok {
+				return nil, fmt.Errorf("unknown law %q", number)
+			}
+			ret = append(ret, law.Law)
+		}
+		return ret, nil
+	}
+
+	var err error
+	for i := range f.InLieu.Substitute {
+		p := &f.InLieu.Substitute[i]
+		if p.Laws, err = resolve(p.LawNumbers); err != nil {
+			report("substitute %q: %w", p.Effective, err)
+		}
+	}
+	for i := range f.InLieu.Citizens {
+		p := &f.InLieu.Citizens[i]
+		if p.Laws, err = resolve(p.LawNumbers); err != nil {
+			report("citizens %q: %w", p.Effective, err)
+		}
+	}
+	for i := range f.Special {
+		d := &f.Special[i]
+		if d.Laws, err = resolve(d.LawNumbers); err != nil {
+			report("special %q: %w", d.Date, err)
+		}
+		if i > 0 && d.Date <= f.Special[i-1].Date {
+			report("special %q: date must be in ascending order", d.Date)
+		}
+		if err := validateEffective(d.Date); err != nil {
+			report("special %q: invalid date", d.Date)
+		}
+		switch d.KindName {
+		case KindSpecial.String():
+			d.Kind = KindSpecial
+		case KindImperialCeremony.String():
+			d.Kind = KindImperialCeremony
+		default:
+			report("special %q: kind must be %q or %q: %q", d.Date, KindSpecial, KindImperialCeremony, d.KindName)
+		}
+		if d.ID == "" || d.Name == "" || d.Kana == "" {
+			report("special %q: the id, the name and the kana are required", d.Date)
+		}
+		if len(d.Laws) == 0 {
+			report("special %q: no laws", d.Date)
+		}
+	}
+	for i := range f.Rules {
+		a := &f.Rules[i]
+		if err := a.resolveLaws(resolve); err != nil {
+			report("rule %d: %w", a.BeginYear, err)
+		}
+		if i > 0 && a.BeginYear < f.Rules[i-1].BeginYear {
+			report("rule %d: begin_year must be in ascending order", a.BeginYear)
+		}
+		if err := a.validate(); err != nil {
+			report("rule %d: %w", a.BeginYear, err)
+		}
+		if len(a.Laws) == 0 {
+			report("rule %d: no laws", a.BeginYear)
+		}
+
+		// the holidays in the rules file cite their own laws.
+		// the holidays in the overlays may omit them. see ruleAmendment.resolveLaws.
+		for _, d := range a.StaticHolydays {
+			if len(d.LawNumbers) == 0 {
+				report("rule %d: static %q: no laws", a.BeginYear, d.Date)
+			}
+		}
+		for _, d := range a.WeekdayHolydays {
+			if len(d.LawNumbers) == 0 {
+				report("rule %d: weekday %s: no laws", a.BeginYear, d.Name)
+			}
+		}
+		for _, d := range a.EquinoxHolydays {
+			if len(d.LawNumbers) == 0 {
+				report("rule %d: equinox %q: no laws", a.BeginYear, d.Equinox)
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	rules, err := expandAmendments(f.Rules)
+	if err != nil {
+		return nil, fmt.Errorf("holiday: invalid rules: %w", err)
+	}
+	rs := &RuleSet{
+		inLieu:      f.InLieu,
+		rules:       rules,
+		special:     f.Special,
+		amendments:  f.Rules,
+		laws:        laws,
+		established: establishedYears(f.Rules, laws),
+	}
+	if err := rs.validate(); err != nil {
+		return nil, err
+	}
+	return rs, nil
+}
+
+// expandAmendments merges the amendments into the rules in descending order of BeginYear.
+// A new rule begins in the year that an amendment begins or the year after an amendment ends.
+// The amendments with the same BeginYear apply in the order of the slice.
+func expandAmendments(amendments []ruleAmendment) ([]annuallyHolidaysRule, error) {
+	years := make(map[int]bool)
+	for _, a := range amendments {
+		years[a.BeginYear] = true
+		if a.EndYear != 0 {
+			years[a.EndYear+1] = true
+		}
+	}
+	beginYears := make([]int, 0, len(years))
+	for year := range years {
+		beginYears = append(beginYears, year)
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(beginYears)))
+
+	var rules []annuallyHolidaysRule
+	for _, year := range beginYears {
+		rule := annuallyHolidaysRule{BeginYear: year}
+		for _, a := range amendments {
+			if !a.appliesTo(year) {
+				continue
+			}
+			if err := a.applyTo(&rule); err != nil {
+				return nil, fmt.Errorf("rule %d: %w", a.BeginYear, err)
+			}
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+// establishedYears returns the years that the laws of the amendments adding the holidays were published, keyed by the identifier.
+// The laws without the published date are ignored.
+func establishedYears(amendments []ruleAmendment, laws map[string]lawEntry) map[string]int {
+	ret := make(map[string]int)
+	for _, a := range amendments {
+		var year int
+		for _, law := range a.Laws {
+			published, err := ParseDate(laws[law.Number].Published)
+			if err != nil {
+				continue
+			}
+			if year == 0 || published.Year < year {
+				year = published.Year
+			}
+		}
+		if year == 0 {
+			continue
+		}
+		for _, id := range a.holidayIDs() {
+			if _, ok := ret[id]; !ok {
+				ret[id] = year
+			}
+		}
+	}
+	return ret
+}
+
+// LoadRuleSetFile loads the rules of the national holidays from the YAML file.
+func LoadRuleSetFile(name string) (*RuleSet, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ParseRuleSet(f)
+}
+
+// startYear returns the first year that the rules define holidays.
+func (rs *RuleSet) startYear() int {
+	if len(rs.rules) == 0 {
+		// no holidays are known. see AsOf.
+		return calendarMaxYear + 1
+	}
+	return rs.rules[len(rs.rules)-1].BeginYear
+}
+
+// ruleOf returns the rule that is enforced in the year.
+// It returns nil if no rule is enforced.
+func (rs *RuleSet) ruleOf(year int) *annuallyHolidaysRule {
+	for i := range rs.rules {
+		if year >= rs.rules[i].BeginYear {
+			return &rs.rules[i]
+		}
+	}
+	return nil
+}
+
+// substitutePolicyOf returns the policy of the substitute holidays that applies to the national holiday on the date.
+// It returns nil if no policy applies.
+func (rs *RuleSet) substitutePolicyOf(date string) *substitutePolicy {
+	var ret *substitutePolicy
+	for i, p := range rs.inLieu.Substitute {
+		if p.Effective <= date {
+			ret = &rs.inLieu.Substitute[i]
+		}
+	}
+	return ret
+}
+
+// citizensPolicyOf returns the policy of the citizens' holidays that applies to the date.
+// It returns nil if no policy applies.
+func (rs *RuleSet) citizensPolicyOf(date string) *citizensPolicy {
+	var ret *citizensPolicy
+	for i, p := range rs.inLieu.Citizens {
+		if p.Effective <= date {
+			ret = &rs.inLieu.Citizens[i]
+		}
+	}
+	return ret
+}
+
+var weekdayNames = map[string]time.Weekday{
+	"sunday":    time.Sunday,
+	"monday":    time.Monday,
+	"tuesday":   time.Tuesday,
+	"wednesday": time.Wednesday,
+	"thursday":  time.Thursday,
+	"friday":    time.Friday,
+	"saturday":  time.Saturday,
+}
+
+// validate validates the rule set and fills the fields that are parsed from other fields.
+// It reports all the problems that are found.
+func (rs *RuleSet) validate() error {
+	var errs []error
+	report := func(format string, args ...any) {
+		errs = append(errs, fmt.Errorf("holiday: invalid rules: "+format, args...))
+	}
+
+	if len(rs.rules) == 0 {
+		report("no rules")
+	}
+	for i := range rs.rules {
+		rule := &rs.rules[i]
+		if i > 0 && rule.BeginYear >= rs.rules[i-1].BeginYear {
+			// the rules are sorted by expandAmendments and RuleSet.Apply, so it must not happen.
+			report("rule %d: begin_year must be in descending order", rule.BeginYear)
+		}
+		if len(rule.Laws) == 0 {
+			report("rule %d: no laws", rule.BeginYear)
+		}
+		for _, law := range rule.Laws {
+			if err := validateLaw(law); err != nil {
+				report("rule %d: %w", rule.BeginYear, err)
+			}
+		}
+
+		ids := make(map[string]bool)
+		checkID := func(id string) {
+			if id != "" && ids[id] {
+				report("rule %d: duplicated id: %q", rule.BeginYear, id)
+			}
+			ids[id] = true
+		}
+
+		dates := make(map[string]bool)
+		for _, d := range rule.StaticHolydays {
+			checkID(d.ID)
+			date, err := ParseDate("2001-" + d.Date)
+			if err != nil || !date.isValid() {
+				// February 29 is rejected, because it is not in common years.
+				report("rule %d: static %q: invalid date", rule.BeginYear, d.Date)
+			}
+			if dates[d.Date] {
+				report("rule %d: static %q: duplicated date", rule.BeginYear, d.Date)
+			}
+			dates[d.Date] = true
+			if err := validateHolyday(d.ID, d.Name, d.Kana, d.Purpose, d.Laws); err != nil {
+				report("rule %d: static %q: %w", rule.BeginYear, d.Date, err)
+			}
+		}
+		for j := range rule.WeekdayHolydays {
+			d := &rule.WeekdayHolydays[j]
+			checkID(d.ID)
+			if d.Month < time.January || d.Month > time.December {
+				report("rule %d: weekday %s: invalid month: %d", rule.BeginYear, d.Name, d.Month)
+			}
+			weekday, ok := weekdayNames[d.WeekdayName]
+			if !ok {
+				report("rule %d: weekday %s: invalid weekday: %q", rule.BeginYear, d.Name, d.WeekdayName)
+			}
+			d.Weekday = weekday
+
+			// the fifth weekday is not in every month.
+			if d.Nth < 1 || d.Nth > 4 {
+				report("rule %d: weekday %s: nth must be between 1 and 4: %d", rule.BeginYear, d.Name, d.Nth)
+			}
+			if err := validateHolyday(d.ID, d.Name, d.Kana, d.Purpose, d.Laws); err != nil {
+				report("rule %d: weekday %s: %w", rule.BeginYear, d.Name, err)
+			}
+		}
+		equinoxes := make(map[string]bool)
+		for _, d := range rule.EquinoxHolydays {
+			checkID(d.ID)
+			if d.Equinox != equinoxVernal && d.Equinox != equinoxAutumnal {
+				report("rule %d: equinox %q: must be %q or %q", rule.BeginYear, d.Equinox, equinoxVernal, equinoxAutumnal)
+			}
+			if equinoxes[d.Equinox] {
+				report("rule %d: equinox %q: duplicated", rule.BeginYear, d.Equinox)
+			}
+			equinoxes[d.Equinox] = true
+			if err := validateHolyday(d.ID, d.Name, d.Kana, d.Purpose, d.Laws); err != nil {
+				report("rule %d: equinox %q: %w", rule.BeginYear, d.Equinox, err)
+			}
+		}
+	}
+
+	for i, p := range rs.inLieu.Substitute {
+		if err := validateEffective(p.Effective); err != nil {
+			report("substitute %q: %w", p.Effective, err)
+		}
+		if i > 0 && p.Effective <= rs.inLieu.Substitute[i-1].Effective {
+			report("substitute %q: effective must be in ascending order", p.Effective)
+		}
+		if p.Rule != substituteNextDay && p.Rule != substituteNearestNonHoliday {
+			report("substitute %q: rule must be %q or %q: %q", p.Effective, substituteNextDay, substituteNearestNonHoliday, p.Rule)
+		}
+		if len(p.Laws) == 0 {
+			report("substitute %q: no laws", p.Effective)
+		}
+		for _, law := range p.Laws {
+			if err := validateLaw(law); err != nil {
+				report("substitute %q: %w", p.Effective, err)
+			}
+		}
+	}
+	for i, p := range rs.inLieu.Citizens {
+		if err := validateEffective(p.Effective); err != nil {
+			report("citizens %q: %w", p.Effective, err)
+		}
+		if i > 0 && p.Effective <= rs.inLieu.Citizens[i-1].Effective {
+			report("citizens %q: effective must be in ascending order", p.Effective)
+		}
+		if len(p.Laws) == 0 {
+			report("citizens %q: no laws", p.Effective)
+		}
+		for _, law := range p.Laws {
+			if err := validateLaw(law); err != nil {
+				report("citizens %q: %w", p.Effective, err)
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func validateLaw(law Law) error {
+	if law.Number == "" || law.Title == "" {
+		return errors.New("the number and the title of the law are required")
+	}
+	return nil
+}
+
+func validateEffective(s string) error {
+	d, err := ParseDate(s)
+	if err != nil || !d.isValid() || d.String() != s {
+		return errors.New("invalid effective date")
+	}
+	return nil
+}
+
+func validateHolyday(id, name, kana, purpose string, laws []Law) error {
+	if id == "" {
+		return errors.New("no id")
+	}
+	if name == "" {
+		return errors.New("no name")
+	}
+	if purpose == "" {
+		return errors.New("no purpose")
+	}
+	if kana == "" {
+		return errors.New("no kana")
+	}
+	for _, r := range kana {
+		if !unicode.In(r, unicode.Hiragana) && !strings.ContainsRune("ー（）", r) {
+			return fmt.Errorf("kana must be in hiragana: %q", kana)
+		}
+	}
+	for _, law := range laws {
+		if err := validateLaw(law); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,233 @@
+package holiday
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLoadRuleSetFile(t *testing.T) {
+	rs, err := LoadRuleSetFile("rules.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(rs, DefaultRuleSet()) {
+		t.Error("the rules in rules.yaml and DefaultRuleSet are different")
+	}
+	if rs.startYear() != 1948 {
+		t.Errorf("want 1948, got %d", rs.startYear())
+	}
+
+	if _, err := LoadRuleSetFile(filepath.Join(t.TempDir(), "rules.yaml")); err == nil {
+		t.Error("want error, got nil")
+	}
+}
+
+func TestDefaultRuleSet_1948(t *testing.T) {
+	// the law was enacted on July 20, 1948, after the vernal equinox day.
+	if h, ok := FindHoliday(1948, time.March, 21); ok {
+		t.Errorf("want no holiday, got %v", h)
+	}
+	if h, ok := FindHoliday(1948, time.September, 23); !ok || h.Name != "秋分の日" {
+		t.Errorf("want 秋分の日, got %v, %t", h, ok)
+	}
+}
+
+// testRules returns the rules that have holidays on Sunday May 2, 2100 and Monday May 3, 2100.
+func testRules(substitute string) string {
+	return `
+laws:
+  - number: テスト法律第一号
+    title: テストの法律
+    published: "2099-01-01"
+  - number: テスト法律第二号
+    title: テストの法律
+    published: "2099-01-01"
+in_lieu:
+  substitute:
+    - effective: "2100-01-01"
+      rule: ` + substitute + `
+      laws: [テスト法律第一号]
+special:
+  - date: "2100-12-01"
+    kind: special
+    id: test-special-day
+    name: 休日（祝日扱い）
+    kana: きゅうじつ（しゅくじつあつかい）
+    laws: [テスト法律第一号]
+rules:
+  - begin_year: 2100
+    laws: [テスト法律第二号]
+    static:
+      - date: "05-02"
+        id: test-day
+        name: テストの日
+        kana: てすとのひ
+        purpose: テストをする。
+        laws: [テスト法律第二号]
+      - date: "05-03"
+        id: constitution-memorial-day
+        name: 憲法記念日
+        kana: けんぽうきねんび
+        purpose: 日本国憲法の施行を記念し、国の成長を期する。
+        laws: [テスト法律第二号]
+    weekday:
+      - month: 1
+        weekday: monday
+        nth: 2
+        id: coming-of-age-day
+        name: 成人の日
+        kana: せいじんのひ
+        purpose: おとなになつたことを自覚し、みずから生き抜こうとする青年を祝いはげます。
+        laws: [テスト法律第二号]
+    equinox:
+      - equinox: vernal
+        id: vernal-equinox-day
+        name: 春分の日
+        kana: しゅんぶんのひ
+        purpose: 自然をたたえ、生物をいつくしむ。
+        laws: [テスト法律第二号]
+`
+}
+
+func TestSetRuleSet(t *testing.T) {
+	tests := []struct {
+		substitute string
+		want       []Holiday
+	}{
+		{
+			substitute: "next-day",
+			want: []Holiday{
+				{Date: "2100-05-02", Name: "テストの日", ID: "test-day", Kana: "てすとのひ"},
+				{Date: "2100-05-03", Name: "憲法記念日", ID: "constitution-memorial-day", Kana: "けんぽうきねんび"},
+			},
+		},
+		{
+			substitute: "nearest-non-holiday",
+			want: []Holiday{
+				{Date: "2100-05-02", Name: "テストの日", ID: "test-day", Kana: "てすとのひ"},
+				{Date: "2100-05-03", Name: "憲法記念日", ID: "constitution-memorial-day", Kana: "けんぽうきねんび"},
+				{Date: "2100-05-04", Name: "休日", Kind: KindSubstitute, ID: "substitute-holiday", Kana: "きゅうじつ"},
+			},
+		},
+	}
+	t.Cleanup(func() { SetRuleSet(nil) })
+
+	for _, tt := range tests {
+		rs, err := ParseRuleSet(strings.NewReader(testRules(tt.substitute)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		SetRuleSet(rs)
+
+		if CurrentRuleSet() != rs {
+			t.Errorf("%s: CurrentRuleSet: want the new rule set", tt.substitute)
+		}
+		if got := FindHolidaysInMonth(2100, time.May); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: FindHolidaysInMonth: want %v, got %v", tt.substitute, tt.want, got)
+		}
+		if h, ok := FindHoliday(2100, time.January, 11); !ok || h.Name != "成人の日" {
+			t.Errorf("%s: FindHoliday: want 成人の日, got %v, %t", tt.substitute, h, ok)
+		}
+		if h, ok := FindHoliday(2100, time.March, vernalEquinoxDay(2100)); !ok || h.Name != "春分の日" {
+			t.Errorf("%s: FindHoliday: want 春分の日, got %v, %t", tt.substitute, h, ok)
+		}
+		if got := FindHolidaysInMonth(2100, time.September); len(got) != 0 {
+			t.Errorf("%s: FindHolidaysInMonth: want no holidays, got %v", tt.substitute, got)
+		}
+
+		// no rule is enforced before 2100.
+		if got := FindHolidaysInYear(2099); len(got) != 0 {
+			t.Errorf("%s: FindHolidaysInYear: want no holidays, got %v", tt.substitute, got)
+		}
+	}
+
+	SetRuleSet(nil)
+	if CurrentRuleSet() != DefaultRuleSet() {
+		t.Error("CurrentRuleSet: want the default rule set")
+	}
+}
+
+func TestParseRuleSet_Invalid(t *testing.T) {
+	valid := testRules("next-day")
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{"empty", ""},
+		{"no rules", "in_lieu: {}\nrules: []\n"},
+		{"unknown field", valid + "unknown: true\n"},
+		{"unknown substitute rule", strings.Replace(valid, "rule: next-day", "rule: previous-day", 1)},
+		{"invalid effective date", strings.Replace(valid, `"2100-01-01"`, `"2100-13-01"`, 1)},
+		{"invalid static date", strings.Replace(valid, `"05-02"`, `"02-30"`, 1)},
+		{"duplicated static date", strings.Replace(valid, `"05-02"`, `"05-03"`, 1)},
+		{"duplicated id", strings.Replace(valid, "id: test-day", "id: constitution-memorial-day", 1)},
+		{"no id", strings.Replace(valid, "id: test-day", "id: ''", 1)},
+		{"no purpose", strings.Replace(valid, "purpose: テストをする。", "purpose: ''", 1)},
+		{"kana in katakana", strings.Replace(valid, "kana: てすとのひ", "kana: テストノヒ", 1)},
+		{"invalid month", strings.Replace(valid, "month: 1", "month: 13", 1)},
+		{"invalid weekday", strings.Replace(valid, "weekday: monday", "weekday: mon", 1)},
+		{"invalid nth", strings.Replace(valid, "nth: 2", "nth: 5", 1)},
+		{"invalid equinox", strings.Replace(valid, "equinox: vernal", "equinox: summer", 1)},
+		{"no laws", strings.Replace(valid, "    laws: [テスト法律第二号]\n", "", 1)},
+		{"holiday without laws", strings.Replace(valid, "        laws: [テスト法律第二号]\n", "", 1)},
+		{"unknown holiday law", strings.Replace(valid, "        laws: [テスト法律第二号]\n", "        laws: [テスト法律第三号]\n", 1)},
+		{"unknown law", strings.Replace(valid, "laws: [テスト法律第二号]", "laws: [テスト法律第三号]", 1)},
+		{"duplicated law", strings.Replace(valid, "number: テスト法律第二号", "number: テスト法律第一号", 1)},
+		{"no published date", strings.Replace(valid, "    published: \"2099-01-01\"\n", "", 1)},
+		{"descending begin_year", valid + "  - begin_year: 2099\n    laws: [テスト法律第二号]\n    remove: [test-day]\n"},
+		{"end_year before begin_year", valid + "  - begin_year: 2101\n    end_year: 2100\n    laws: [テスト法律第二号]\n    remove: [test-day]\n"},
+		{"no changes", valid + "  - begin_year: 2101\n    laws: [テスト法律第二号]\n"},
+		{"remove unknown holiday", valid + "  - begin_year: 2101\n    laws: [テスト法律第二号]\n    remove: [unknown-day]\n"},
+		{"duplicated id after merge", valid + "  - begin_year: 2101\n    laws: [テスト法律第二号]\n    static:\n      - date: \"12-31\"\n        id: test-day\n        name: テストの日\n        kana: てすとのひ\n        purpose: テストをする。\n"},
+		{"unknown special kind", strings.Replace(valid, "kind: special", "kind: national", 1)},
+		{"invalid special date", strings.Replace(valid, `"2100-12-01"`, `"2100-02-30"`, 1)},
+		{"special without laws", strings.Replace(valid, "    laws: [テスト法律第一号]\n", "", 1)},
+	}
+	for _, tt := range tests {
+		if tt.input == valid {
+			t.Fatalf("%s: the input is not modified", tt.name)
+		}
+		if _, err := ParseRuleSet(strings.NewReader(tt.input)); err == nil {
+			t.Errorf("%s: want error, got nil", tt.name)
+		}
+	}
+}
+
+func TestParseRuleSet_Override(t *testing.T) {
+	// the bundled rules can be copied and modified at runtime.
+	data, err := os.ReadFile("rules.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// an amendment in 2100 adds a holiday to the current holidays.
+	input := string(data) + `
+  - begin_year: 2100
+    laws: [昭和二十三年法律第百七十八号]
+    static:
+      - date: "12-31"
+        id: test-day
+        name: テストの日
+        kana: てすとのひ
+        purpose: テストをする。
+        laws: [昭和二十三年法律第百七十八号]
+`
+	rs, err := ParseRuleSet(strings.NewReader(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want2099 := FindHolidaysInYear(2099)
+	want := append(FindHolidaysInYear(2100), Holiday{Date: "2100-12-31", Name: "テストの日", ID: "test-day", Kana: "てすとのひ"})
+	SetRuleSet(rs)
+	t.Cleanup(func() { SetRuleSet(nil) })
+
+	if got := FindHolidaysInYear(2100); !reflect.DeepEqual(got, want) {
+		t.Errorf("2100: want %v, got %v", want, got)
+	}
+	if got := FindHolidaysInYear(2099); !reflect.DeepEqual(got, want2099) {
+		t.Errorf("2099: want %v, got %v", want2099, got)
+	}
+}
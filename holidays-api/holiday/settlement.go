@@ -0,0 +1,34 @@
+package holiday
+
+import "time"
+
+// BusinessDayOnOrBefore returns d if d is a business day in the calendar,
+// otherwise the last business day before d.
+func (c *Calendar) BusinessDayOnOrBefore(d Date) Date {
+	if c.IsBusinessDay(d) {
+		return d
+	}
+	return c.PreviousBusinessDay(d)
+}
+
+// BusinessDayOnOrAfter returns d if d is a business day in the calendar,
+// otherwise the first business day after d.
+func (c *Calendar) BusinessDayOnOrAfter(d Date) Date {
+	if c.IsBusinessDay(d) {
+		return d
+	}
+	return c.NextBusinessDay(d)
+}
+
+// SettlementDate returns the settlement date for the day of the month,
+// such as "the 25th, or the previous bank business day if it is a holiday" (25日、休日の場合は前営業日).
+// If the month doesn't have the day, e.g. the 31st of June, the last day of the month is used.
+// If backward is true, it moves to the previous business day, otherwise to the next business day.
+func (c *Calendar) SettlementDate(year int, month time.Month, day int, backward bool) Date {
+	last := Date{year, month, 1}.lastDay()
+	d := Date{year, month, min(max(day, 1), last.Day)}
+	if backward {
+		return c.BusinessDayOnOrBefore(d)
+	}
+	return c.BusinessDayOnOrAfter(d)
+}
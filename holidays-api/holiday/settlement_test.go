@@ -0,0 +1,57 @@
+package holiday
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCalendar_SettlementDate(t *testing.T) {
+	bank := Bank()
+	tests := []struct {
+		year     int
+		month    time.Month
+		day      int
+		backward bool
+		want     Date
+	}{
+		// 2025-05-25 is Sunday.
+		{2025, time.May, 25, true, Date{2025, time.May, 23}},
+		{2025, time.May, 25, false, Date{2025, time.May, 26}},
+
+		// 2025-06-25 is Wednesday.
+		{2025, time.June, 25, true, Date{2025, time.June, 25}},
+
+		// June doesn't have the 31st.
+		{2025, time.June, 31, false, Date{2025, time.June, 30}},
+
+		// 2025-11-30 is Sunday.
+		{2025, time.November, 31, true, Date{2025, time.November, 28}},
+
+		// December 31 is a bank holiday.
+		{2025, time.December, 31, true, Date{2025, time.December, 30}},
+		{2025, time.December, 31, false, Date{2026, time.January, 5}},
+	}
+	for _, tt := range tests {
+		got := bank.SettlementDate(tt.year, tt.month, tt.day, tt.backward)
+		if got != tt.want {
+			t.Errorf("%d-%02d-%02d (backward: %t): want %s, got %s", tt.year, tt.month, tt.day, tt.backward, tt.want, got)
+		}
+	}
+}
+
+func TestCalendar_BusinessDayOnOrAfter(t *testing.T) {
+	bank := Bank()
+	d := Date{2025, time.January, 6}
+	if got := bank.BusinessDayOnOrAfter(d); got != d {
+		t.Errorf("want %s, got %s", d, got)
+	}
+	if got := bank.BusinessDayOnOrBefore(d); got != d {
+		t.Errorf("want %s, got %s", d, got)
+	}
+	if got, want := bank.BusinessDayOnOrAfter(Date{2025, time.January, 1}), (Date{2025, time.January, 6}); got != want {
+		t.Errorf("want %s, got %s", want, got)
+	}
+	if got, want := bank.BusinessDayOnOrBefore(Date{2025, time.January, 5}), (Date{2024, time.December, 30}); got != want {
+		t.Errorf("want %s, got %s", want, got)
+	}
+}
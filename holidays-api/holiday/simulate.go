@@ -0,0 +1,534 @@
+package holiday
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"slices"
+	"sort"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// RuleOverlay is a hypothetical amendment of the law, such as a bill that adds or moves holidays.
+// It is applied to a RuleSet by RuleSet.Apply, or simulated by Simulate.
+type RuleOverlay struct {
+	laws       []lawEntry
+	amendments []ruleAmendment
+	inLieu     inLieuRules
+}
+
+// ruleOverlayFile is the format of the rule overlay file.
+type ruleOverlayFile struct {
+	Laws       []lawEntry      `yaml:"laws"`
+	Amendments []ruleAmendment `yaml:"amendments"`
+	InLieu     inLieuRules     `yaml:"in_lieu"`
+}
+
+type ruleAmendment struct {
+	// BeginYear is the first year that the amendment applies.
+	BeginYear int `yaml:"begin_year"`
+
+	// EndYear is the last year that the amendment applies.
+	// Zero means that the amendment applies forever.
+	// It is useful for one-year shifts, such as the holidays moved for the Olympic Games in 2020 and 2021.
+	EndYear int `yaml:"end_year"`
+
+	// Laws are the laws that define the amendment. They are optional for bills.
+	// They are resolved from LawNumbers.
+	Laws       []Law    `yaml:"-"`
+	LawNumbers []string `yaml:"laws"`
+
+	// Remove are the identifiers of the holidays that are removed from the rules.
+	// Remove the holiday and add it again to move the holiday.
+	Remove []string `yaml:"remove"`
+
+	// StaticHolydays, WeekdayHolydays and EquinoxHolydays are the holidays that are added to the rules.
+	StaticHolydays  []staticHolyday  `yaml:"static"`
+	WeekdayHolydays []weekdayHolyday `yaml:"weekday"`
+	EquinoxHolydays []equinoxHolyday `yaml:"equinox"`
+}
+
+// ParseRuleOverlay parses a rule overlay in the YAML format.
+// The laws, the holidays and the policies of the holidays in lieu have the same format as rules.yaml in the package.
+// The laws are cited by the number, from the laws in the overlay or the rule set that the overlay is applied to.
+// The holidays without their own laws cite the laws of the amendment.
+//
+//	# move 海の日 to July 20 and add a new holiday from 2027.
+//	laws:
+//	  - number: 令和九年法律第一号
+//	    title: 国民の祝日に関する法律の一部を改正する法律案
+//	amendments:
+//	  - begin_year: 2027
+//	    laws: [令和九年法律第一号]
+//	    remove: [marine-day]
+//	    static:
+//	      - date: "07-20"
+//	        id: marine-day
+//	        name: 海の日
+//	        kana: うみのひ
+//	        purpose: 海の恩恵に感謝するとともに、海洋国日本の繁栄を願う。
+//	      - date: "12-01"
+//	        id: new-day
+//	        name: 新しい祝日
+//	        kana: あたらしいしゅくじつ
+//	        purpose: 新しい祝日を祝う。
+//	# a one-year shift
+//	  - begin_year: 2028
+//	    end_year: 2028
+//	    remove: [sports-day]
+//	    static:
+//	      - date: "07-21"
+//	        id: sports-day
+//	        name: スポーツの日
+//	        kana: すぽーつのひ
+//	        purpose: スポーツを楽しみ、他者を尊重する精神を培うとともに、健康で活力ある社会の実現を願う。
+func ParseRuleOverlay(r io.Reader) (*RuleOverlay, error) {
+	dec := yaml.NewDecoder(r)
+	dec.KnownFields(true)
+
+	var f ruleOverlayFile
+	if err := dec.Decode(&f); err != nil {
+		return nil, fmt.Errorf("holiday: failed to parse rule overlay: %w", err)
+	}
+	o := &RuleOverlay{
+		laws:       f.Laws,
+		amendments: f.Amendments,
+		inLieu:     f.InLieu,
+	}
+	if err := o.validate(); err != nil {
+		return nil, err
+	}
+	return o, nil
+}
+
+// LoadRuleOverlayFile loads a rule overlay from the YAML file.
+func LoadRuleOverlayFile(name string) (*RuleOverlay, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ParseRuleOverlay(f)
+}
+
+// validate validates the overlay itself.
+// The holidays are validated when the overlay is applied to a RuleSet.
+func (o *RuleOverlay) validate() error {
+	var errs []error
+	report := func(format string, args ...any) {
+		errs = append(errs, fmt.Errorf("holiday: invalid rule overlay: "+format, args...))
+	}
+
+	if len(o.amendments) == 0 && len(o.inLieu.Substitute) == 0 && len(o.inLieu.Citizens) == 0 {
+		report("no amendments")
+	}
+	for _, law := range o.laws {
+		if err := validateLaw(law.Law); err != nil {
+			report("law %q: %w", law.Number, err)
+		}
+		// the bills are not published yet.
+		if law.Published != "" {
+			if err := validateEffective(law.Published); err != nil {
+				report("law %q: invalid published date", law.Number)
+			}
+		}
+	}
+	for i := range o.amendments {
+		a := &o.amendments[i]
+		if err := a.validate(); err != nil {
+			report("amendment %d: %w", a.BeginYear, err)
+		}
+	}
+	for _, p := range o.inLieu.Substitute {
+		if err := validateEffective(p.Effective); err != nil {
+			report("substitute %q: %w", p.Effective, err)
+		}
+	}
+	for _, p := range o.inLieu.Citizens {
+		if err := validateEffective(p.Effective); err != nil {
+			report("citizens %q: %w", p.Effective, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// BeginYear returns the first year that the overlay changes.
+func (o *RuleOverlay) BeginYear() int {
+	year := calendarMaxYear
+	for _, a := range o.amendments {
+		year = min(year, a.BeginYear)
+	}
+	for _, p := range o.inLieu.Substitute {
+		year = min(year, mustParseDate(p.Effective).Year())
+	}
+	for _, p := range o.inLieu.Citizens {
+		year = min(year, mustParseDate(p.Effective).Year())
+	}
+	return year
+}
+
+// Apply returns a new rule set that the overlay is applied to.
+// rs is not modified.
+func (rs *RuleSet) Apply(o *RuleOverlay) (*RuleSet, error) {
+	// the overlay cites the laws in rs and the laws in the overlay.
+	laws := maps.Clone(rs.laws)
+	if laws == nil {
+		laws = make(map[string]lawEntry, len(o.laws))
+	}
+	for _, law := range o.laws {
+		laws[law.Number] = law
+	}
+	resolve := func(numbers []string) ([]Law, error) {
+		ret := make([]Law, 0, len(numbers))
+		for _, number := range numbers {
+			law, ok := laws[number]
+			if !ok {
+				return nil, fmt.Errorf("unknown law %q", number)
+			}
+			ret = append(ret, law.Law)
+		}
+		return ret, nil
+	}
+	amendments := slices.Clone(o.amendments)
+	for i := range amendments {
+		if err := amendments[i].resolveLaws(resolve); err != nil {
+			return nil, fmt.Errorf("holiday: invalid rule overlay: amendment %d: %w", amendments[i].BeginYear, err)
+		}
+	}
+	substitute := slices.Clone(o.inLieu.Substitute)
+	for i := range substitute {
+		var err error
+		if substitute[i].Laws, err = resolve(substitute[i].LawNumbers); err != nil {
+			return nil, fmt.Errorf("holiday: invalid rule overlay: substitute %q: %w", substitute[i].Effective, err)
+		}
+	}
+	citizens := slices.Clone(o.inLieu.Citizens)
+	for i := range citizens {
+		var err error
+		if citizens[i].Laws, err = resolve(citizens[i].LawNumbers); err != nil {
+			return nil, fmt.Errorf("holiday: invalid rule overlay: citizens %q: %w", citizens[i].Effective, err)
+		}
+	}
+
+	// split the rules at the years that the amendments begin and end.
+	start := rs.startYear()
+	years := make(map[int]bool)
+	for _, rule := range rs.rules {
+		years[rule.BeginYear] = true
+	}
+	for _, a := range amendments {
+		if a.BeginYear < start {
+			return nil, fmt.Errorf("holiday: invalid rule overlay: amendment %d: no rule is enforced before %d", a.BeginYear, start)
+		}
+		years[a.BeginYear] = true
+		if a.EndYear != 0 {
+			years[a.EndYear+1] = true
+		}
+	}
+	beginYears := make([]int, 0, len(years))
+	for year := range years {
+		beginYears = append(beginYears, year)
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(beginYears)))
+
+	// the holidays that the overlay adds are established by the overlay.
+	established := maps.Clone(rs.established)
+	if established == nil {
+		established = make(map[string]int)
+	}
+	for id, year := range establishedYears(amendments, laws) {
+		if _, ok := established[id]; !ok {
+			established[id] = year
+		}
+	}
+
+	ret := &RuleSet{
+		inLieu: inLieuRules{
+			Substitute: mergePolicies(rs.inLieu.Substitute, substitute, func(p substitutePolicy) string { return p.Effective }),
+			Citizens:   mergePolicies(rs.inLieu.Citizens, citizens, func(p citizensPolicy) string { return p.Effective }),
+		},
+		special:     rs.special,
+		laws:        laws,
+		established: established,
+	}
+	for _, year := range beginYears {
+		rule := rs.ruleOf(year).clone()
+		rule.BeginYear = year
+		for _, a := range amendments {
+			if !a.appliesTo(year) {
+				continue
+			}
+			if err := a.applyTo(&rule); err != nil {
+				return nil, fmt.Errorf("holiday: invalid rule overlay: amendment %d: %w", a.BeginYear, err)
+			}
+		}
+		ret.rules = append(ret.rules, rule)
+	}
+
+	if err := ret.validate(); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+func (rule *annuallyHolidaysRule) clone() annuallyHolidaysRule {
+	return annuallyHolidaysRule{
+		BeginYear:       rule.BeginYear,
+		Laws:            slices.Clone(rule.Laws),
+		StaticHolydays:  slices.Clone(rule.StaticHolydays),
+		WeekdayHolydays: slices.Clone(rule.WeekdayHolydays),
+		EquinoxHolydays: slices.Clone(rule.EquinoxHolydays),
+	}
+}
+
+// validate validates the amendment itself, and fills the fields that are parsed from other fields.
+// The holidays are validated after the amendment is merged into the rules.
+func (a *ruleAmendment) validate() error {
+	var errs []error
+	if a.BeginYear <= 0 {
+		errs = append(errs, errors.New("invalid begin_year"))
+	}
+	if a.EndYear != 0 && a.EndYear < a.BeginYear {
+		errs = append(errs, fmt.Errorf("end_year must be on or after begin_year: %d", a.EndYear))
+	}
+	if len(a.Remove) == 0 && len(a.StaticHolydays) == 0 && len(a.WeekdayHolydays) == 0 && len(a.EquinoxHolydays) == 0 {
+		errs = append(errs, errors.New("no changes"))
+	}
+	for i := range a.WeekdayHolydays {
+		// the invalid names are reported by RuleSet.validate.
+		d := &a.WeekdayHolydays[i]
+		d.Weekday = weekdayNames[d.WeekdayName]
+	}
+	return errors.Join(errs...)
+}
+
+// resolveLaws resolves the laws of the amendment and the holidays that it adds.
+// The holidays without their own laws cite the laws of the amendment.
+// The holidays are copied, so that the amendment shared with others is not modified.
+func (a *ruleAmendment) resolveLaws(resolve func(numbers []string) ([]Law, error)) error {
+	var err error
+	if a.Laws, err = resolve(a.LawNumbers); err != nil {
+		return err
+	}
+	holidayLaws := func(numbers []string) ([]Law, error) {
+		if len(numbers) == 0 {
+			return a.Laws, nil
+		}
+		return resolve(numbers)
+	}
+
+	a.StaticHolydays = slices.Clone(a.StaticHolydays)
+	for i := range a.StaticHolydays {
+		d := &a.StaticHolydays[i]
+		if d.Laws, err = holidayLaws(d.LawNumbers); err != nil {
+			return fmt.Errorf("static %q: %w", d.Date, err)
+		}
+	}
+	a.WeekdayHolydays = slices.Clone(a.WeekdayHolydays)
+	for i := range a.WeekdayHolydays {
+		d := &a.WeekdayHolydays[i]
+		if d.Laws, err = holidayLaws(d.LawNumbers); err != nil {
+			return fmt.Errorf("weekday %s: %w", d.Name, err)
+		}
+	}
+	a.EquinoxHolydays = slices.Clone(a.EquinoxHolydays)
+	for i := range a.EquinoxHolydays {
+		d := &a.EquinoxHolydays[i]
+		if d.Laws, err = holidayLaws(d.LawNumbers); err != nil {
+			return fmt.Errorf("equinox %q: %w", d.Equinox, err)
+		}
+	}
+	return nil
+}
+
+// appliesTo reports whether the amendment applies to the year.
+func (a *ruleAmendment) appliesTo(year int) bool {
+	return a.BeginYear <= year && (a.EndYear == 0 || year <= a.EndYear)
+}
+
+// holidayIDs returns the identifiers of the holidays that the amendment adds.
+func (a *ruleAmendment) holidayIDs() []string {
+	var ids []string
+	for _, d := range a.StaticHolydays {
+		ids = append(ids, d.ID)
+	}
+	for _, d := range a.WeekdayHolydays {
+		ids = append(ids, d.ID)
+	}
+	for _, d := range a.EquinoxHolydays {
+		ids = append(ids, d.ID)
+	}
+	return ids
+}
+
+func (a *ruleAmendment) applyTo(rule *annuallyHolidaysRule) error {
+	for _, id := range a.Remove {
+		n := len(rule.StaticHolydays) + len(rule.WeekdayHolydays) + len(rule.EquinoxHolydays)
+		rule.StaticHolydays = slices.DeleteFunc(rule.StaticHolydays, func(d staticHolyday) bool { return d.ID == id })
+		rule.WeekdayHolydays = slices.DeleteFunc(rule.WeekdayHolydays, func(d weekdayHolyday) bool { return d.ID == id })
+		rule.EquinoxHolydays = slices.DeleteFunc(rule.EquinoxHolydays, func(d equinoxHolyday) bool { return d.ID == id })
+		if n == len(rule.StaticHolydays)+len(rule.WeekdayHolydays)+len(rule.EquinoxHolydays) {
+			return fmt.Errorf("%q is not a holiday in %d", id, rule.BeginYear)
+		}
+	}
+	for _, law := range a.Laws {
+		if !slices.ContainsFunc(rule.Laws, func(l Law) bool { return l.Number == law.Number }) {
+			rule.Laws = append(rule.Laws, law)
+		}
+	}
+	rule.StaticHolydays = append(rule.StaticHolydays, a.StaticHolydays...)
+	rule.WeekdayHolydays = append(rule.WeekdayHolydays, a.WeekdayHolydays...)
+	rule.EquinoxHolydays = append(rule.EquinoxHolydays, a.EquinoxHolydays...)
+	return nil
+}
+
+// mergePolicies merges the policies in ascending order of the effective date.
+// The duplicated effective dates are reported by RuleSet.validate.
+func mergePolicies[T any](base, overlay []T, effective func(T) string) []T {
+	ret := slices.Concat(base, overlay)
+	slices.SortStableFunc(ret, func(a, b T) int {
+		switch ea, eb := effective(a), effective(b); {
+		case ea < eb:
+			return -1
+		case ea > eb:
+			return 1
+		}
+		return 0
+	})
+	return ret
+}
+
+// Simulation is the result of Simulate.
+type Simulation struct {
+	// From and To are the range of the simulation, inclusive.
+	From, To Date
+
+	// Holidays are the holidays between From and To under the amended law.
+	Holidays []Holiday
+
+	// Diffs are the differences between the current holidays and Holidays.
+	Diffs []SimulationDiff
+
+	// BusinessDays is the number of business days between From and To, inclusive, under the current law.
+	BusinessDays int
+
+	// SimulatedBusinessDays is the number of business days between From and To, inclusive, under the amended law.
+	SimulatedBusinessDays int
+}
+
+// SimulationDiff is a difference between the current holidays and the simulated holidays.
+type SimulationDiff struct {
+	// Date is the date in the "2006-01-02" format.
+	Date string
+
+	// Current is the holiday under the current law.
+	// It is the zero value if the date is not a holiday currently.
+	Current Holiday
+
+	// Simulated is the holiday under the amended law.
+	// It is the zero value if the date is not a holiday in the simulation.
+	Simulated Holiday
+}
+
+func (d SimulationDiff) String() string {
+	return fmt.Sprintf("%s: current %s, simulated %s", d.Date, formatMismatchHoliday(d.Current), formatMismatchHoliday(d.Simulated))
+}
+
+// Simulate calculates the holidays between from and to, inclusive, under the law amended by o,
+// and compares them with the current holidays.
+// The years before o.BeginYear() are the same as the current holidays.
+func Simulate(o *RuleOverlay, from, to Date) (*Simulation, error) {
+	if from.Compare(to) > 0 {
+		from, to = to, from
+	}
+	rs, err := loadRuleSet().Apply(o)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Simulation{
+		From: from,
+		To:   to,
+	}
+	begin := o.BeginYear()
+	startDate := from.String()
+	endDate := to.String()
+	for m := from.firstDay(); m.Compare(to) <= 0; m = m.nextMonth() {
+		current := FindHolidaysInMonth(m.Year, m.Month)
+		simulated := current
+		if m.Year >= begin {
+			simulated = rs.holidaysInMonth(m.Year, m.Month)
+		}
+		current = holidaysBetween(current, startDate, endDate)
+		simulated = holidaysBetween(simulated, startDate, endDate)
+
+		s.Holidays = append(s.Holidays, simulated...)
+		s.Diffs = append(s.Diffs, diffHolidays(current, simulated)...)
+		s.BusinessDays += countBusinessDays(m, from, to, current)
+		s.SimulatedBusinessDays += countBusinessDays(m, from, to, simulated)
+	}
+	return s, nil
+}
+
+// holidaysBetween returns the holidays between startDate and endDate, inclusive.
+func holidaysBetween(holidays []Holiday, startDate, endDate string) []Holiday {
+	var ret []Holiday
+	for _, h := range holidays {
+		if startDate <= h.Date && h.Date <= endDate {
+			ret = append(ret, h)
+		}
+	}
+	return ret
+}
+
+// diffHolidays compares the sorted holidays.
+func diffHolidays(current, simulated []Holiday) []SimulationDiff {
+	var diffs []SimulationDiff
+	i, j := 0, 0
+	for i < len(current) || j < len(simulated) {
+		switch {
+		case j >= len(simulated) || (i < len(current) && current[i].Date < simulated[j].Date):
+			// the holiday is removed
+			diffs = append(diffs, SimulationDiff{Date: current[i].Date, Current: current[i]})
+			i++
+		case i >= len(current) || simulated[j].Date < current[i].Date:
+			// the holiday is added
+			diffs = append(diffs, SimulationDiff{Date: simulated[j].Date, Simulated: simulated[j]})
+			j++
+		default:
+			if current[i] != simulated[j] {
+				diffs = append(diffs, SimulationDiff{Date: current[i].Date, Current: current[i], Simulated: simulated[j]})
+			}
+			i++
+			j++
+		}
+	}
+	return diffs
+}
+
+// countBusinessDays counts the business days in the month m between from and to, inclusive.
+func countBusinessDays(m, from, to Date, holidays []Holiday) int {
+	isHoliday := make(map[string]bool, len(holidays))
+	for _, h := range holidays {
+		isHoliday[h.Date] = true
+	}
+
+	var n int
+	for d := m; d.Month == m.Month && d.Compare(to) <= 0; d = d.AddDays(1) {
+		if d.Compare(from) < 0 {
+			continue
+		}
+		switch d.Weekday() {
+		case time.Saturday, time.Sunday:
+			continue
+		}
+		if !isHoliday[d.String()] {
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,239 @@
+package holiday
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// testRuleOverlay moves 海の日 to July 20 and adds a new holiday on December 1 from 2028.
+const testRuleOverlay = `
+laws:
+  - number: テスト法律第一号
+    title: テストの法律
+amendments:
+  - begin_year: 2028
+    laws: [テスト法律第一号]
+    remove: [marine-day]
+    static:
+      - date: "07-20"
+        id: marine-day
+        name: 海の日
+        kana: うみのひ
+        purpose: 海の恩恵に感謝するとともに、海洋国日本の繁栄を願う。
+      - date: "12-01"
+        id: test-day
+        name: テストの日
+        kana: てすとのひ
+        purpose: テストをする。
+`
+
+func TestSimulate(t *testing.T) {
+	o, err := ParseRuleOverlay(strings.NewReader(testRuleOverlay))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if o.BeginYear() != 2028 {
+		t.Errorf("BeginYear: want 2028, got %d", o.BeginYear())
+	}
+
+	s, err := Simulate(o, Date{2028, 12, 31}, Date{2027, 12, 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.From != (Date{2027, 12, 1}) || s.To != (Date{2028, 12, 31}) {
+		t.Errorf("want 2027-12-01 - 2028-12-31, got %s - %s", s.From, s.To)
+	}
+
+	wantDiffs := []SimulationDiff{
+		{
+			Date:    "2028-07-17",
+			Current: Holiday{Date: "2028-07-17", Name: "海の日", ID: "marine-day", Kana: "うみのひ"},
+		},
+		{
+			Date:      "2028-07-20",
+			Simulated: Holiday{Date: "2028-07-20", Name: "海の日", ID: "marine-day", Kana: "うみのひ"},
+		},
+		{
+			Date:      "2028-12-01",
+			Simulated: Holiday{Date: "2028-12-01", Name: "テストの日", ID: "test-day", Kana: "てすとのひ"},
+		},
+	}
+	if !reflect.DeepEqual(s.Diffs, wantDiffs) {
+		t.Errorf("Diffs: want %v, got %v", wantDiffs, s.Diffs)
+	}
+
+	// there are no holidays in December 2027.
+	if len(s.Holidays) == 0 || s.Holidays[0].Date != "2028-01-01" {
+		t.Errorf("Holidays: want 2028-01-01 first, got %v", s.Holidays)
+	}
+	if s.Holidays[len(s.Holidays)-1].Date != "2028-12-01" {
+		t.Errorf("Holidays: want 2028-12-01 last, got %v", s.Holidays)
+	}
+
+	// 2028-07-17 (Monday) becomes a business day, and 2028-07-20 (Thursday) and 2028-12-01 (Friday) become holidays.
+	if got, want := s.SimulatedBusinessDays-s.BusinessDays, -1; got != want {
+		t.Errorf("business days: want %d, got %d", want, got)
+	}
+	if got, want := s.BusinessDays, BusinessDaysBetween(Date{2027, 11, 30}, Date{2028, 12, 31}); got != want {
+		t.Errorf("BusinessDays: want %d, got %d", want, got)
+	}
+
+	// the current rule set is not modified.
+	if h, ok := FindHoliday(2028, 7, 17); !ok || h.Name != "海の日" {
+		t.Errorf("FindHoliday: want 海の日, got %v, %t", h, ok)
+	}
+}
+
+func TestSimulate_OneYear(t *testing.T) {
+	input := strings.Replace(testRuleOverlay, "  - begin_year: 2028\n", "  - begin_year: 2028\n    end_year: 2028\n", 1)
+	o, err := ParseRuleOverlay(strings.NewReader(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s, err := Simulate(o, Date{2028, 1, 1}, Date{2029, 12, 31})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, d := range s.Diffs {
+		if !strings.HasPrefix(d.Date, "2028-") {
+			t.Errorf("unexpected diff: %v", d)
+		}
+	}
+	if len(s.Diffs) != 3 {
+		t.Errorf("want 3 diffs, got %v", s.Diffs)
+	}
+}
+
+func TestSimulate_InLieu(t *testing.T) {
+	// 2031-05-04 is a Sunday, and 2031-05-05 is a national holiday.
+	input := `
+in_lieu:
+  substitute:
+    - effective: "2031-01-01"
+      rule: next-day
+      laws: [昭和二十三年法律第百七十八号]
+`
+	o, err := ParseRuleOverlay(strings.NewReader(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s, err := Simulate(o, Date{2031, 5, 1}, Date{2031, 5, 31})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []SimulationDiff{
+		{
+			Date:    "2031-05-06",
+			Current: Holiday{Date: "2031-05-06", Name: "休日", Kind: KindSubstitute, ID: "substitute-holiday", Kana: "きゅうじつ"},
+		},
+	}
+	if !reflect.DeepEqual(s.Diffs, want) {
+		t.Errorf("want %v, got %v", want, s.Diffs)
+	}
+	if got, want := s.SimulatedBusinessDays-s.BusinessDays, 1; got != want {
+		t.Errorf("business days: want %d, got %d", want, got)
+	}
+}
+
+func TestRuleSet_Apply(t *testing.T) {
+	o, err := ParseRuleOverlay(strings.NewReader(testRuleOverlay))
+	if err != nil {
+		t.Fatal(err)
+	}
+	base, err := LoadRuleSetFile("rules.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	rs, err := base.Apply(o)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the base rule set is not modified.
+	if !reflect.DeepEqual(base, DefaultRuleSet()) {
+		t.Error("the base rule set is modified")
+	}
+
+	// the amended rule set can replace the current one.
+	SetRuleSet(rs)
+	t.Cleanup(func() { SetRuleSet(nil) })
+	if h, ok := FindHoliday(2100, 12, 1); !ok || h.Name != "テストの日" {
+		t.Errorf("FindHoliday: want テストの日, got %v, %t", h, ok)
+	}
+	if h, ok := FindHoliday(2027, 7, 19); !ok || h.Name != "海の日" {
+		t.Errorf("FindHoliday: want 海の日, got %v, %t", h, ok)
+	}
+
+	// the holidays without their own laws cite the laws of the amendment.
+	for _, d := range rs.ruleOf(2100).StaticHolydays {
+		if d.ID == "test-day" && (len(d.Laws) != 1 || d.Laws[0].Number != "テスト法律第一号") {
+			t.Errorf("%s: want the laws [テスト法律第一号], got %v", d.Name, d.Laws)
+		}
+	}
+}
+
+func TestRuleSet_Apply_Invalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{"unknown holiday", strings.Replace(testRuleOverlay, "remove: [marine-day]", "remove: [unknown-day]", 1)},
+		{"duplicated id", strings.Replace(testRuleOverlay, "remove: [marine-day]", "remove: []", 1)},
+		{"duplicated date", strings.Replace(testRuleOverlay, `"12-01"`, `"11-03"`, 1)},
+		{"before the law", strings.Replace(testRuleOverlay, "begin_year: 2028", "begin_year: 1947", 1)},
+		{"invalid kana", strings.Replace(testRuleOverlay, "kana: てすとのひ", "kana: テストノヒ", 1)},
+		{"unknown law", strings.Replace(testRuleOverlay, "laws: [テスト法律第一号]", "laws: [テスト法律第二号]", 1)},
+	}
+	for _, tt := range tests {
+		if tt.input == testRuleOverlay {
+			t.Fatalf("%s: the input is not modified", tt.name)
+		}
+		o, err := ParseRuleOverlay(strings.NewReader(tt.input))
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if _, err := DefaultRuleSet().Apply(o); err == nil {
+			t.Errorf("%s: want error, got nil", tt.name)
+		}
+	}
+}
+
+func TestParseRuleOverlay_Invalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{"empty", ""},
+		{"no amendments", "amendments: []\n"},
+		{"unknown field", testRuleOverlay + "unknown: true\n"},
+		{"end_year before begin_year", strings.Replace(testRuleOverlay, "  - begin_year: 2028\n", "  - begin_year: 2028\n    end_year: 2027\n", 1)},
+		{"no changes", "amendments:\n  - begin_year: 2028\n"},
+		{"invalid effective date", "in_lieu:\n  citizens:\n    - effective: \"2028-02-30\"\n"},
+		{"no title", strings.Replace(testRuleOverlay, "    title: テストの法律\n", "", 1)},
+		{"invalid published date", strings.Replace(testRuleOverlay, "    title: テストの法律\n", "    title: テストの法律\n    published: \"2028-13-01\"\n", 1)},
+	}
+	for _, tt := range tests {
+		if _, err := ParseRuleOverlay(strings.NewReader(tt.input)); err == nil {
+			t.Errorf("%s: want error, got nil", tt.name)
+		}
+	}
+}
+
+func TestLoadRuleOverlayFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "bill.yaml")
+	if err := os.WriteFile(path, []byte(testRuleOverlay), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadRuleOverlayFile(path); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadRuleOverlayFile(filepath.Join(t.TempDir(), "bill.yaml")); err == nil {
+		t.Error("want error, got nil")
+	}
+}
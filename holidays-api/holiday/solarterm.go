@@ -0,0 +1,164 @@
+package holiday
+
+import (
+	"sort"
+	"strconv"
+	"time"
+)
+
+// SolarTerm is one of the twenty-four solar terms (二十四節気).
+// The value is the index in the order of the ecliptic longitude of the sun from the vernal equinox.
+type SolarTerm int
+
+const (
+	SolarTermShunbun   SolarTerm = iota // 春分, 0°
+	SolarTermSeimei                     // 清明, 15°
+	SolarTermKokuu                      // 穀雨, 30°
+	SolarTermRikka                      // 立夏, 45°
+	SolarTermShoman                     // 小満, 60°
+	SolarTermBoshu                      // 芒種, 75°
+	SolarTermGeshi                      // 夏至, 90°
+	SolarTermShousho                    // 小暑, 105°
+	SolarTermTaisho                     // 大暑, 120°
+	SolarTermRisshu                     // 立秋, 135°
+	SolarTermShosho                     // 処暑, 150°
+	SolarTermHakuro                     // 白露, 165°
+	SolarTermShubun                     // 秋分, 180°
+	SolarTermKanro                      // 寒露, 195°
+	SolarTermSoko                       // 霜降, 210°
+	SolarTermRitto                      // 立冬, 225°
+	SolarTermShosetsu                   // 小雪, 240°
+	SolarTermTaisetsu                   // 大雪, 255°
+	SolarTermToji                       // 冬至, 270°
+	SolarTermShokan                     // 小寒, 285°
+	SolarTermDaikan                     // 大寒, 300°
+	SolarTermRisshun                    // 立春, 315°
+	SolarTermUsui                       // 雨水, 330°
+	SolarTermKeichitsu                  // 啓蟄, 345°
+)
+
+// the number of the solar terms.
+const solarTermCount = 24
+
+// seasonName is the names of a solar term or a seasonal day.
+type seasonName struct {
+	Japanese string
+	translation
+}
+
+var seasonNames = [solarTermCount]seasonName{
+	SolarTermShunbun:   {"春分", translation{"Vernal Equinox", "Shunbun"}},
+	SolarTermSeimei:    {"清明", translation{"Clear and Bright", "Seimei"}},
+	SolarTermKokuu:     {"穀雨", translation{"Grain Rain", "Kokuu"}},
+	SolarTermRikka:     {"立夏", translation{"Beginning of Summer", "Rikka"}},
+	SolarTermShoman:    {"小満", translation{"Grain Buds", "Shōman"}},
+	SolarTermBoshu:     {"芒種", translation{"Grain in Ear", "Bōshu"}},
+	SolarTermGeshi:     {"夏至", translation{"Summer Solstice", "Geshi"}},
+	SolarTermShousho:   {"小暑", translation{"Minor Heat", "Shōsho"}},
+	SolarTermTaisho:    {"大暑", translation{"Major Heat", "Taisho"}},
+	SolarTermRisshu:    {"立秋", translation{"Beginning of Autumn", "Risshū"}},
+	SolarTermShosho:    {"処暑", translation{"End of Heat", "Shosho"}},
+	SolarTermHakuro:    {"白露", translation{"White Dew", "Hakuro"}},
+	SolarTermShubun:    {"秋分", translation{"Autumnal Equinox", "Shūbun"}},
+	SolarTermKanro:     {"寒露", translation{"Cold Dew", "Kanro"}},
+	SolarTermSoko:      {"霜降", translation{"Frost's Descent", "Sōkō"}},
+	SolarTermRitto:     {"立冬", translation{"Beginning of Winter", "Rittō"}},
+	SolarTermShosetsu:  {"小雪", translation{"Minor Snow", "Shōsetsu"}},
+	SolarTermTaisetsu:  {"大雪", translation{"Major Snow", "Taisetsu"}},
+	SolarTermToji:      {"冬至", translation{"Winter Solstice", "Tōji"}},
+	SolarTermShokan:    {"小寒", translation{"Minor Cold", "Shōkan"}},
+	SolarTermDaikan:    {"大寒", translation{"Major Cold", "Daikan"}},
+	SolarTermRisshun:   {"立春", translation{"Beginning of Spring", "Risshun"}},
+	SolarTermUsui:      {"雨水", translation{"Rain Water", "Usui"}},
+	SolarTermKeichitsu: {"啓蟄", translation{"Awakening of Insects", "Keichitsu"}},
+}
+
+func (term SolarTerm) valid() bool {
+	return 0 <= term && term < solarTermCount
+}
+
+// String returns the name of the solar term in Japanese. e.g. "春分"
+func (term SolarTerm) String() string {
+	if !term.valid() {
+		return "SolarTerm(" + strconv.Itoa(int(term)) + ")"
+	}
+	return seasonNames[term].Japanese
+}
+
+// LocalizedName returns the name of the solar term in the language.
+func (term SolarTerm) LocalizedName(lang Language) string {
+	if !term.valid() {
+		return term.String()
+	}
+	switch lang {
+	case English:
+		return seasonNames[term].English
+	case Romaji:
+		return seasonNames[term].Romaji
+	}
+	return seasonNames[term].Japanese
+}
+
+// Longitude returns the ecliptic longitude of the sun at the solar term, in degrees.
+func (term SolarTerm) Longitude() float64 {
+	return float64(term) * 15
+}
+
+// In returns the instant of the solar term in the year, in Japan Standard Time.
+// Each solar term occurs once a year.
+// The instant is calculated from the approximation of the ecliptic longitude of the sun,
+// so it may differ from the one published by the National Astronomical Observatory of Japan by a few minutes.
+func (term SolarTerm) In(year int) time.Time {
+	return sunLongitudeTime(year, term.Longitude())
+}
+
+// SolarTermTime is the instant of a solar term.
+type SolarTermTime struct {
+	Term SolarTerm
+	Time time.Time
+}
+
+// SolarTermsInYear returns the solar terms in the year, in chronological order.
+func SolarTermsInYear(year int) []SolarTermTime {
+	ret := make([]SolarTermTime, 0, solarTermCount)
+	for term := SolarTerm(0); term < solarTermCount; term++ {
+		ret = append(ret, SolarTermTime{
+			Term: term,
+			Time: term.In(year),
+		})
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].Time.Before(ret[j].Time)
+	})
+	return ret
+}
+
+// sunLongitudeTime returns the instant when the ecliptic longitude of the sun is lon degrees in the year.
+func sunLongitudeTime(year int, lon float64) time.Time {
+	// estimate the instant from the mean motion of the sun.
+	// the sun is at 0° around March 20, and the longitudes over 280° are in January to March.
+	const tropicalYear = 365.2422 // days
+	deg := lon
+	if deg >= 280 {
+		deg -= 360
+	}
+	days := deg / 360 * tropicalYear
+	estimated := time.Date(year, time.March, 20, 12, 0, 0, 0, jst).Add(time.Duration(days * float64(24*time.Hour)))
+
+	// the real instant is within a few days from the estimation in the recent years,
+	// but the Gregorian calendar drifts from the seasons in the distant years.
+	// search it by bisection with enough margin.
+	lo := estimated.Add(-15 * 24 * time.Hour)
+	hi := estimated.Add(15 * 24 * time.Hour)
+	for hi.Sub(lo) > time.Second {
+		mid := lo.Add(hi.Sub(lo) / 2)
+		diff := normalizeDegree(sunLongitude(time2JulianYear(mid)) - lon + 180)
+		if diff < 180 {
+			// the sun has not reached lon yet.
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return hi.Truncate(time.Second).In(jst)
+}
@@ -0,0 +1,85 @@
+package holiday
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSolarTerm_In(t *testing.T) {
+	// 令和7年(2025) 暦要項 - 国立天文台
+	// https://eco.mtk.nao.ac.jp/koyomi/yoko/2025/rekiyou252.html
+	tests := []struct {
+		term SolarTerm
+		want time.Time
+	}{
+		{SolarTermShokan, time.Date(2025, time.January, 5, 11, 33, 0, 0, jst)},
+		{SolarTermDaikan, time.Date(2025, time.January, 20, 5, 0, 0, 0, jst)},
+		{SolarTermRisshun, time.Date(2025, time.February, 3, 23, 10, 0, 0, jst)},
+		{SolarTermUsui, time.Date(2025, time.February, 18, 19, 7, 0, 0, jst)},
+		{SolarTermKeichitsu, time.Date(2025, time.March, 5, 17, 7, 0, 0, jst)},
+		{SolarTermShunbun, time.Date(2025, time.March, 20, 18, 1, 0, 0, jst)},
+		{SolarTermSeimei, time.Date(2025, time.April, 4, 21, 49, 0, 0, jst)},
+		{SolarTermKokuu, time.Date(2025, time.April, 20, 4, 56, 0, 0, jst)},
+		{SolarTermRikka, time.Date(2025, time.May, 5, 14, 57, 0, 0, jst)},
+		{SolarTermShoman, time.Date(2025, time.May, 21, 3, 55, 0, 0, jst)},
+		{SolarTermBoshu, time.Date(2025, time.June, 5, 18, 57, 0, 0, jst)},
+		{SolarTermGeshi, time.Date(2025, time.June, 21, 11, 42, 0, 0, jst)},
+		{SolarTermShousho, time.Date(2025, time.July, 7, 5, 5, 0, 0, jst)},
+		{SolarTermTaisho, time.Date(2025, time.July, 22, 22, 29, 0, 0, jst)},
+		{SolarTermRisshu, time.Date(2025, time.August, 7, 14, 52, 0, 0, jst)},
+		{SolarTermShosho, time.Date(2025, time.August, 23, 5, 34, 0, 0, jst)},
+		{SolarTermHakuro, time.Date(2025, time.September, 7, 17, 52, 0, 0, jst)},
+		{SolarTermShubun, time.Date(2025, time.September, 23, 3, 19, 0, 0, jst)},
+		{SolarTermKanro, time.Date(2025, time.October, 8, 9, 41, 0, 0, jst)},
+		{SolarTermSoko, time.Date(2025, time.October, 23, 12, 51, 0, 0, jst)},
+		{SolarTermRitto, time.Date(2025, time.November, 7, 13, 4, 0, 0, jst)},
+		{SolarTermShosetsu, time.Date(2025, time.November, 22, 10, 36, 0, 0, jst)},
+		{SolarTermTaisetsu, time.Date(2025, time.December, 7, 6, 5, 0, 0, jst)},
+		{SolarTermToji, time.Date(2025, time.December, 22, 0, 3, 0, 0, jst)},
+	}
+	for _, tt := range tests {
+		got := tt.term.In(2025)
+		// the approximation of the ecliptic longitude of the sun has errors of a few minutes.
+		if diff := got.Sub(tt.want).Abs(); diff > 3*time.Minute {
+			t.Errorf("%s: want %s, got %s", tt.term, tt.want, got)
+		}
+		if got.Location() != jst {
+			t.Errorf("%s: want JST, got %s", tt.term, got.Location())
+		}
+	}
+}
+
+func TestSolarTermsInYear(t *testing.T) {
+	for _, year := range []int{1, 1600, 2025, 3000, 9999} {
+		terms := SolarTermsInYear(year)
+		if len(terms) != 24 {
+			t.Fatalf("%d: want 24 solar terms, got %d", year, len(terms))
+		}
+		for i, term := range terms {
+			if term.Time.Year() != year {
+				t.Errorf("%d: %s is in %s", year, term.Term, term.Time)
+			}
+			if i > 0 && !terms[i-1].Time.Before(term.Time) {
+				t.Errorf("%d: %s is not after %s", year, term.Term, terms[i-1].Term)
+			}
+		}
+	}
+	if got := SolarTermsInYear(2025)[0].Term; got != SolarTermShokan {
+		t.Errorf("the first solar term: want 小寒, got %s", got)
+	}
+}
+
+func TestSolarTerm_LocalizedName(t *testing.T) {
+	if got, want := SolarTermGeshi.LocalizedName(Japanese), "夏至"; got != want {
+		t.Errorf("want %q, got %q", want, got)
+	}
+	if got, want := SolarTermGeshi.LocalizedName(English), "Summer Solstice"; got != want {
+		t.Errorf("want %q, got %q", want, got)
+	}
+	if got, want := SolarTermToji.LocalizedName(Romaji), "Tōji"; got != want {
+		t.Errorf("want %q, got %q", want, got)
+	}
+	if got, want := SolarTerm(24).String(), "SolarTerm(24)"; got != want {
+		t.Errorf("want %q, got %q", want, got)
+	}
+}
@@ -0,0 +1,149 @@
+package holiday
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Mismatch is a difference between the holidays calculated based on the law and the table.
+type Mismatch struct {
+	// Date is the date in the "2006-01-02" format.
+	Date string
+
+	// Table is the holiday in the table.
+	// It is the zero value if the table doesn't have the holiday.
+	Table Holiday
+
+	// Calculated is the holiday calculated based on the law.
+	// It is the zero value if the calculation doesn't have the holiday.
+	Calculated Holiday
+
+	// Rule describes the rule that the calculation applied to the date.
+	Rule string
+}
+
+func (m Mismatch) String() string {
+	return fmt.Sprintf("%s: table %s, calculated %s (%s)", m.Date, formatMismatchHoliday(m.Table), formatMismatchHoliday(m.Calculated), m.Rule)
+}
+
+func formatMismatchHoliday(h Holiday) string {
+	if h.Date == "" {
+		return "none"
+	}
+	return fmt.Sprintf("%q (%s)", h.Name, h.Kind)
+}
+
+// Verify compares the holidays calculated based on the law with the table in ds,
+// and returns all of the mismatches.
+func Verify(ds *Dataset) []Mismatch {
+	rs := loadRuleSet()
+	var mismatches []Mismatch
+	for year := ds.startYear; year <= ds.endYear; year++ {
+		mismatches = append(mismatches, verifyYear(rs, year, ds.findHolidaysInYear(year), rs.holidaysInYear(year))...)
+	}
+	return mismatches
+}
+
+func verifyYear(rs *RuleSet, year int, table, calculated []Holiday) []Mismatch {
+	var mismatches []Mismatch
+	i, j := 0, 0
+	for i < len(table) || j < len(calculated) {
+		var m Mismatch
+		switch {
+		case j >= len(calculated) || (i < len(table) && table[i].Date < calculated[j].Date):
+			// the calculation misses the holiday
+			m = Mismatch{Date: table[i].Date, Table: table[i]}
+			i++
+		case i >= len(table) || calculated[j].Date < table[i].Date:
+			// the calculation has an extra holiday
+			m = Mismatch{Date: calculated[j].Date, Calculated: calculated[j]}
+			j++
+		default:
+			a, b := table[i], calculated[j]
+			i++
+			j++
+			if a == b {
+				continue
+			}
+			m = Mismatch{Date: a.Date, Table: a, Calculated: b}
+		}
+		m.Rule = explainRule(rs, year, m)
+		mismatches = append(mismatches, m)
+	}
+	return mismatches
+}
+
+// explainRule describes the rule of rs that the calculation applied to the mismatch.
+func explainRule(rs *RuleSet, year int, m Mismatch) string {
+	rule := rs.ruleOf(year)
+	if rule == nil {
+		return fmt.Sprintf("no rule is enforced in %d", year)
+	}
+	prefix := fmt.Sprintf("the rule since %d", rule.BeginYear)
+
+	h := m.Calculated
+	if h.Date == "" {
+		h = m.Table
+	}
+	date := mustParseDate(m.Date)
+
+	switch h.Name {
+	case "春分の日":
+		return fmt.Sprintf("%s: the vernal equinox day is calculated as March %d", prefix, vernalEquinoxDay(year))
+	case "秋分の日":
+		return fmt.Sprintf("%s: the autumnal equinox day is calculated as September %d", prefix, autumnalEquinoxDay(year))
+	}
+	switch h.Kind {
+	case KindSubstitute:
+		return prefix + ": substitute holiday (Article 3-2)"
+	case KindCitizens:
+		return prefix + ": citizens' holiday (Article 3-3)"
+	case KindSpecial, KindImperialCeremony:
+		for _, d := range rs.special {
+			if d.Date == m.Date {
+				return "special holiday " + d.Name
+			}
+		}
+		return "no special holiday is defined"
+	}
+
+	for _, d := range rule.StaticHolydays {
+		if d.Name == h.Name {
+			return fmt.Sprintf("%s: %s is on %s", prefix, d.Name, d.Date)
+		}
+	}
+	for _, d := range rule.WeekdayHolydays {
+		if d.Name == h.Name {
+			return fmt.Sprintf("%s: %s is on %s %s of %s", prefix, d.Name, ordinal(d.Nth), d.Weekday, d.Month)
+		}
+	}
+
+	var names []string
+	for _, d := range rule.StaticHolydays {
+		if strings.HasPrefix(d.Date, fmt.Sprintf("%02d-", int(date.Month()))) {
+			names = append(names, d.Name+" on "+d.Date)
+		}
+	}
+	for _, d := range rule.WeekdayHolydays {
+		if d.Month == date.Month() {
+			names = append(names, fmt.Sprintf("%s on %s %s", d.Name, ordinal(d.Nth), d.Weekday))
+		}
+	}
+	if len(names) == 0 {
+		return fmt.Sprintf("%s: no holiday in %s", prefix, date.Month())
+	}
+	return fmt.Sprintf("%s: %s has %s", prefix, date.Month(), strings.Join(names, ", "))
+}
+
+func ordinal(n int) string {
+	switch n {
+	case 1:
+		return "1st"
+	case 2:
+		return "2nd"
+	case 3:
+		return "3rd"
+	default:
+		return fmt.Sprintf("%dth", n)
+	}
+}
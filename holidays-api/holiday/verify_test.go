@@ -0,0 +1,80 @@
+package holiday
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestVerify(t *testing.T) {
+	for _, m := range Verify(DefaultDataset()) {
+		t.Error(m)
+	}
+}
+
+func TestVerify_Mismatch(t *testing.T) {
+	input := encodeShiftJIS(t, "国民の祝日・休日月日,国民の祝日・休日名称\r\n"+
+		"2030/1/1,元日\r\n"+
+		"2030/1/14,成人の日\r\n"+
+		"2030/2/11,建国記念の日\r\n"+
+		"2030/2/23,天皇誕生日\r\n"+
+		"2030/3/21,春分の日\r\n"+ // calculated as March 20
+		"2030/4/29,昭和の日\r\n"+
+		"2030/5/3,憲法記念日\r\n"+
+		"2030/5/4,みどりの日\r\n"+
+		"2030/5/5,こどもの日\r\n"+
+		"2030/5/6,休日\r\n"+
+		"2030/7/15,海の日\r\n"+
+		"2030/8/11,山の日\r\n"+
+		"2030/8/12,休日\r\n"+
+		"2030/9/16,敬老の日\r\n"+
+		"2030/9/23,秋分の日\r\n"+
+		"2030/10/14,スポーツの日\r\n"+
+		"2030/11/3,文化の日\r\n"+
+		"2030/11/4,休日\r\n"+
+		"2030/11/23,勤労感謝の日\r\n")
+	ds, err := ParseCSV(strings.NewReader(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := Verify(ds)
+	if len(got) != 2 {
+		t.Fatalf("want 2 mismatches, got %v", got)
+	}
+
+	if got[0].Date != "2030-03-20" || got[0].Table.Date != "" || got[0].Calculated.Name != "春分の日" {
+		t.Errorf("unexpected mismatch: %v", got[0])
+	}
+	if got[1].Date != "2030-03-21" || got[1].Table.Name != "春分の日" || got[1].Calculated.Date != "" {
+		t.Errorf("unexpected mismatch: %v", got[1])
+	}
+	for _, m := range got {
+		if want := "the vernal equinox day is calculated as March 20"; !strings.Contains(m.Rule, want) {
+			t.Errorf("%s: want the rule %q, got %q", m.Date, want, m.Rule)
+		}
+	}
+}
+
+func TestVerify_RuleSet(t *testing.T) {
+	// the rules without the one-off holidays.
+	rs := &RuleSet{
+		inLieu: defaultRuleSet.inLieu,
+		rules:  defaultRuleSet.rules,
+	}
+	SetRuleSet(rs)
+	t.Cleanup(func() { SetRuleSet(nil) })
+
+	var found bool
+	for _, m := range Verify(DefaultDataset()) {
+		if m.Date != "2019-05-01" {
+			continue
+		}
+		found = true
+		if want := "no special holiday is defined"; m.Rule != want {
+			t.Errorf("want the rule %q, got %q", want, m.Rule)
+		}
+	}
+	if !found {
+		t.Error("want a mismatch on 2019-05-01")
+	}
+}
@@ -0,0 +1,164 @@
+package holiday
+
+import (
+	"sort"
+	"strconv"
+)
+
+// Zassetsu is one of the seasonal days (雑節) derived from the solar terms.
+type Zassetsu int
+
+const (
+	// ZassetsuSetsubun is 節分, the day before 立春.
+	ZassetsuSetsubun Zassetsu = iota
+
+	// ZassetsuSpringHiganStart is 春の彼岸入り, three days before 春分.
+	ZassetsuSpringHiganStart
+
+	// ZassetsuSpringHiganEnd is 春の彼岸明け, three days after 春分.
+	ZassetsuSpringHiganEnd
+
+	// ZassetsuHachijuHachiya is 八十八夜, the 88th day counting 立春 as the first day.
+	ZassetsuHachijuHachiya
+
+	// ZassetsuNyubai is 入梅, the day when the ecliptic longitude of the sun is 80°.
+	ZassetsuNyubai
+
+	// ZassetsuHangesho is 半夏生, the day when the ecliptic longitude of the sun is 100°.
+	ZassetsuHangesho
+
+	// ZassetsuNihyakuToka is 二百十日, the 210th day counting 立春 as the first day.
+	ZassetsuNihyakuToka
+
+	// ZassetsuNihyakuHatsuka is 二百二十日, the 220th day counting 立春 as the first day.
+	ZassetsuNihyakuHatsuka
+
+	// ZassetsuAutumnHiganStart is 秋の彼岸入り, three days before 秋分.
+	ZassetsuAutumnHiganStart
+
+	// ZassetsuAutumnHiganEnd is 秋の彼岸明け, three days after 秋分.
+	ZassetsuAutumnHiganEnd
+
+	// ZassetsuWinterDoyo is 冬の土用入り, the day when the ecliptic longitude of the sun is 297°.
+	// 土用 lasts until the day before 立春.
+	ZassetsuWinterDoyo
+
+	// ZassetsuSpringDoyo is 春の土用入り, the day when the ecliptic longitude of the sun is 27°.
+	// 土用 lasts until the day before 立夏.
+	ZassetsuSpringDoyo
+
+	// ZassetsuSummerDoyo is 夏の土用入り, the day when the ecliptic longitude of the sun is 117°.
+	// 土用 lasts until the day before 立秋.
+	ZassetsuSummerDoyo
+
+	// ZassetsuAutumnDoyo is 秋の土用入り, the day when the ecliptic longitude of the sun is 207°.
+	// 土用 lasts until the day before 立冬.
+	ZassetsuAutumnDoyo
+)
+
+// the number of the seasonal days.
+const zassetsuCount = 14
+
+var zassetsuNames = [zassetsuCount]seasonName{
+	ZassetsuSetsubun:         {"節分", translation{"Setsubun", "Setsubun"}},
+	ZassetsuSpringHiganStart: {"彼岸入り", translation{"Beginning of Spring Higan", "Higan-iri"}},
+	ZassetsuSpringHiganEnd:   {"彼岸明け", translation{"End of Spring Higan", "Higan-ake"}},
+	ZassetsuHachijuHachiya:   {"八十八夜", translation{"88th Night", "Hachijū-hachiya"}},
+	ZassetsuNyubai:           {"入梅", translation{"Beginning of the Rainy Season", "Nyūbai"}},
+	ZassetsuHangesho:         {"半夏生", translation{"Hangeshō", "Hangeshō"}},
+	ZassetsuNihyakuToka:      {"二百十日", translation{"210th Day", "Nihyaku-tōka"}},
+	ZassetsuNihyakuHatsuka:   {"二百二十日", translation{"220th Day", "Nihyaku-hatsuka"}},
+	ZassetsuAutumnHiganStart: {"彼岸入り", translation{"Beginning of Autumn Higan", "Higan-iri"}},
+	ZassetsuAutumnHiganEnd:   {"彼岸明け", translation{"End of Autumn Higan", "Higan-ake"}},
+	ZassetsuWinterDoyo:       {"土用入り", translation{"Beginning of Winter Doyō", "Doyō-iri"}},
+	ZassetsuSpringDoyo:       {"土用入り", translation{"Beginning of Spring Doyō", "Doyō-iri"}},
+	ZassetsuSummerDoyo:       {"土用入り", translation{"Beginning of Summer Doyō", "Doyō-iri"}},
+	ZassetsuAutumnDoyo:       {"土用入り", translation{"Beginning of Autumn Doyō", "Doyō-iri"}},
+}
+
+func (z Zassetsu) valid() bool {
+	return 0 <= z && z < zassetsuCount
+}
+
+// String returns the name of the seasonal day in Japanese. e.g. "節分"
+func (z Zassetsu) String() string {
+	if !z.valid() {
+		return "Zassetsu(" + strconv.Itoa(int(z)) + ")"
+	}
+	return zassetsuNames[z].Japanese
+}
+
+// LocalizedName returns the name of the seasonal day in the language.
+func (z Zassetsu) LocalizedName(lang Language) string {
+	if !z.valid() {
+		return z.String()
+	}
+	switch lang {
+	case English:
+		return zassetsuNames[z].English
+	case Romaji:
+		return zassetsuNames[z].Romaji
+	}
+	return zassetsuNames[z].Japanese
+}
+
+// In returns the date of the seasonal day in the year, in Japan Standard Time.
+func (z Zassetsu) In(year int) Date {
+	switch z {
+	case ZassetsuSetsubun:
+		return solarTermDate(year, SolarTermRisshun).AddDays(-1)
+	case ZassetsuSpringHiganStart:
+		return solarTermDate(year, SolarTermShunbun).AddDays(-3)
+	case ZassetsuSpringHiganEnd:
+		return solarTermDate(year, SolarTermShunbun).AddDays(3)
+	case ZassetsuHachijuHachiya:
+		return solarTermDate(year, SolarTermRisshun).AddDays(88 - 1)
+	case ZassetsuNyubai:
+		return FromTime(sunLongitudeTime(year, 80))
+	case ZassetsuHangesho:
+		return FromTime(sunLongitudeTime(year, 100))
+	case ZassetsuNihyakuToka:
+		return solarTermDate(year, SolarTermRisshun).AddDays(210 - 1)
+	case ZassetsuNihyakuHatsuka:
+		return solarTermDate(year, SolarTermRisshun).AddDays(220 - 1)
+	case ZassetsuAutumnHiganStart:
+		return solarTermDate(year, SolarTermShubun).AddDays(-3)
+	case ZassetsuAutumnHiganEnd:
+		return solarTermDate(year, SolarTermShubun).AddDays(3)
+	case ZassetsuWinterDoyo:
+		return FromTime(sunLongitudeTime(year, 297))
+	case ZassetsuSpringDoyo:
+		return FromTime(sunLongitudeTime(year, 27))
+	case ZassetsuSummerDoyo:
+		return FromTime(sunLongitudeTime(year, 117))
+	case ZassetsuAutumnDoyo:
+		return FromTime(sunLongitudeTime(year, 207))
+	}
+	return Date{}
+}
+
+// ZassetsuDate is the date of a seasonal day.
+type ZassetsuDate struct {
+	Zassetsu Zassetsu
+	Date     Date
+}
+
+// ZassetsuInYear returns the seasonal days in the year, in chronological order.
+func ZassetsuInYear(year int) []ZassetsuDate {
+	ret := make([]ZassetsuDate, 0, zassetsuCount)
+	for z := Zassetsu(0); z < zassetsuCount; z++ {
+		ret = append(ret, ZassetsuDate{
+			Zassetsu: z,
+			Date:     z.In(year),
+		})
+	}
+	sort.SliceStable(ret, func(i, j int) bool {
+		return ret[i].Date.Before(ret[j].Date)
+	})
+	return ret
+}
+
+// solarTermDate returns the date of the solar term in Japan Standard Time.
+func solarTermDate(year int, term SolarTerm) Date {
+	return FromTime(term.In(year))
+}
@@ -0,0 +1,50 @@
+package holiday
+
+import (
+	"testing"
+	"time"
+)
+
+func TestZassetsu_In(t *testing.T) {
+	// 令和7年(2025) 暦要項 - 国立天文台
+	// https://eco.mtk.nao.ac.jp/koyomi/yoko/2025/rekiyou253.html
+	tests := []struct {
+		zassetsu Zassetsu
+		want     Date
+	}{
+		{ZassetsuWinterDoyo, Date{2025, time.January, 17}},
+		{ZassetsuSetsubun, Date{2025, time.February, 2}},
+		{ZassetsuSpringHiganStart, Date{2025, time.March, 17}},
+		{ZassetsuSpringHiganEnd, Date{2025, time.March, 23}},
+		{ZassetsuSpringDoyo, Date{2025, time.April, 17}},
+		{ZassetsuHachijuHachiya, Date{2025, time.May, 1}},
+		{ZassetsuNyubai, Date{2025, time.June, 11}},
+		{ZassetsuHangesho, Date{2025, time.July, 1}},
+		{ZassetsuSummerDoyo, Date{2025, time.July, 19}},
+		{ZassetsuNihyakuToka, Date{2025, time.August, 31}},
+		{ZassetsuNihyakuHatsuka, Date{2025, time.September, 10}},
+		{ZassetsuAutumnHiganStart, Date{2025, time.September, 20}},
+		{ZassetsuAutumnHiganEnd, Date{2025, time.September, 26}},
+		{ZassetsuAutumnDoyo, Date{2025, time.October, 20}},
+	}
+	for _, tt := range tests {
+		if got := tt.zassetsu.In(2025); got != tt.want {
+			t.Errorf("%s: want %s, got %s", tt.zassetsu.LocalizedName(English), tt.want, got)
+		}
+	}
+}
+
+func TestZassetsuInYear(t *testing.T) {
+	days := ZassetsuInYear(2025)
+	if len(days) != 14 {
+		t.Fatalf("want 14 days, got %d", len(days))
+	}
+	for i := 1; i < len(days); i++ {
+		if days[i].Date.Before(days[i-1].Date) {
+			t.Errorf("%s is before %s", days[i].Date, days[i-1].Date)
+		}
+	}
+	if got := days[0].Zassetsu; got != ZassetsuWinterDoyo {
+		t.Errorf("the first day: want 冬の土用入り, got %s", got.LocalizedName(English))
+	}
+}
@@ -25,38 +25,6 @@ func init() {
 	}
 }
 
-var errInvalidDateFormat = errors.New("holidaysapi: invalid date format")
-
-func parseDate(s string) (holiday.Date, error) {
-	y, s, ok := strings.Cut(s, "-")
-	if !ok {
-		return holiday.Date{}, errInvalidDateFormat
-	}
-	m, s, ok := strings.Cut(s, "-")
-	if !ok {
-		return holiday.Date{}, errInvalidDateFormat
-	}
-	d := s
-
-	year, err := parseInt(y, 4)
-	if err != nil || year < 1 || year > 9999 {
-		return holiday.Date{}, errInvalidDateFormat
-	}
-	month, err := parseInt(m, 2)
-	if err != nil || month < 1 || month > 12 {
-		return holiday.Date{}, errInvalidDateFormat
-	}
-	day, err := parseInt(d, 2)
-	if err != nil || day < 1 || day > 31 {
-		return holiday.Date{}, errInvalidDateFormat
-	}
-	return holiday.Date{
-		Year:  year,
-		Month: time.Month(month),
-		Day:   day,
-	}, nil
-}
-
 // Response is the response of Handler.
 type Response struct {
 	Holidays []Holiday `json:"holidays"`
@@ -66,6 +34,31 @@ type Response struct {
 type Holiday struct {
 	Date string `json:"date"`
 	Name string `json:"name"`
+
+	// NameKana is the reading (よみ) of the name in Japanese, in hiragana. e.g. "けんこくきねんのひ"
+	// It is in Japanese regardless of the language of the name.
+	NameKana string `json:"name_kana,omitempty"`
+
+	// Kind is the kind of the holiday.
+	// One of "national", "substitute", "citizens", "special" and "imperial-ceremony".
+	Kind string `json:"kind"`
+
+	// ID is the identifier of the holiday. e.g. "sports-day"
+	// It is stable even if the holiday is renamed or moved.
+	// See the by-id api.
+	ID string `json:"id,omitempty"`
+
+	// Era is the name of the Japanese era (元号) of the date. e.g. "令和"
+	// It is omitted if the date is before the Meiji era.
+	Era string `json:"era,omitempty"`
+
+	// EraYear is the year in the era. 1 is 元年.
+	EraYear int `json:"era_year,omitempty"`
+
+	// Detail is the legal basis of the holiday.
+	// It is returned only if it is requested by the detail=true query parameter,
+	// and omitted for the holidays other than the national holidays.
+	Detail *HolidayDetail `json:"detail,omitempty"`
 }
 
 // Handler provides a holiday api.
@@ -82,15 +75,106 @@ func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	cal, err := calendarFromQuery(r.URL)
+	if err != nil {
+		h.responseNotFound(w)
+		return
+	}
+
+	lang := negotiateLanguage(r)
 	path := r.URL.Path
 	path = strings.TrimPrefix(path, "/")
 	path = strings.TrimSuffix(path, "/")
 	if path == "holidays" {
-		if err := h.holidaysInRange(w, r.URL); err != nil {
+		if err := h.holidaysInRange(w, cal, r.URL, lang); err != nil {
+			h.responseNotFound(w)
+		}
+		return
+	}
+	if path == "next" {
+		if err := h.nextHoliday(w, cal, r.URL, lang); err != nil {
+			h.responseNotFound(w)
+		}
+		return
+	}
+	if path == "previous" {
+		if err := h.previousHoliday(w, cal, r.URL, lang); err != nil {
 			h.responseNotFound(w)
 		}
 		return
 	}
+	if path == "paydays" {
+		if h.rejectAsOf(w, r.URL) {
+			return
+		}
+		if err := h.paydays(w, r.URL); err != nil {
+			h.responseNotFound(w)
+		}
+		return
+	}
+	if id, ok := strings.CutPrefix(path, "by-id/"); ok {
+		// by-id/sports-day
+		if err := h.holidaysByID(w, cal, id, r.URL, lang); err != nil {
+			h.responseNotFound(w)
+		}
+		return
+	}
+	if rest, ok := strings.CutPrefix(path, "explain/"); ok {
+		// explain/2006/01/02
+		if h.rejectAsOf(w, r.URL) {
+			return
+		}
+		if err := h.explain(w, rest, lang); err != nil {
+			h.responseNotFound(w)
+		}
+		return
+	}
+	if rest, ok := strings.CutPrefix(path, "rokuyo/"); ok {
+		// rokuyo/2006/01
+		if h.rejectAsOf(w, r.URL) {
+			return
+		}
+		if err := h.rokuyo(w, rest, lang); err != nil {
+			h.responseNotFound(w)
+		}
+		return
+	}
+	if rest, ok := strings.CutPrefix(path, "markets/"); ok {
+		// markets/jpx/2006
+		if h.rejectAsOf(w, r.URL) {
+			return
+		}
+		if err := h.market(w, rest, lang); err != nil {
+			h.responseNotFound(w)
+		}
+		return
+	}
+	if path == "holidays.ics" {
+		if err := h.icalInRange(w, cal, r.URL, lang); err != nil {
+			h.responseNotFound(w)
+		}
+		return
+	}
+	if y, ok := strings.CutSuffix(path, "/long-weekends"); ok {
+		// 2006/long-weekends
+		year, _, err := parseYear(y)
+		if err != nil || year == 0 {
+			h.responseNotFound(w)
+			return
+		}
+		h.longWeekends(w, cal, year, lang)
+		return
+	}
+	if y, ok := strings.CutSuffix(path, ".ics"); ok {
+		// 2006.ics
+		year, err := parseInt(y, 4)
+		if err != nil || year == 0 {
+			h.responseNotFound(w)
+			return
+		}
+		h.icalInYear(w, cal, year, lang)
+		return
+	}
 
 	year, month, day, err := parsePath(r.URL.Path)
 	if err != nil {
@@ -102,14 +186,14 @@ func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		h.responseNotFound(w)
 	case month == 0:
 		// 2006
-		h.holidaysInYear(w, year)
+		h.holidaysInYear(w, cal, year, lang, r.URL.Query().Get("detail") == "true")
 	case day == 0:
 		// 2006/01
 		if month < 1 || month > 12 {
 			h.responseNotFound(w)
 			return
 		}
-		h.holidaysInMonth(w, year, time.Month(month))
+		h.holidaysInMonth(w, cal, year, time.Month(month), lang)
 	default:
 		// 2006/01/02
 		_, err := time.Parse("2006/01/02", fmt.Sprintf("%04d/%02d/%02d", year, month, day))
@@ -117,7 +201,7 @@ func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 			h.responseNotFound(w)
 			return
 		}
-		h.holiday(w, year, time.Month(month), day)
+		h.holiday(w, cal, year, time.Month(month), day, lang)
 	}
 }
 
@@ -128,9 +212,10 @@ func parsePath(path string) (year, month, day int, err error) {
 		return
 	}
 
+	var era holiday.Era
 	seg := strings.SplitN(path, "/", 3)
 	if len(seg) >= 1 {
-		year, err = parseInt(seg[0], 4)
+		year, era, err = parseYear(seg[0])
 		if err != nil {
 			return 0, 0, 0, err
 		}
@@ -147,9 +232,58 @@ func parsePath(path string) (year, month, day int, err error) {
 			return 0, 0, 0, err
 		}
 	}
+
+	if era != holiday.EraNone {
+		// the date must be in the era.
+		switch {
+		case day != 0:
+			if !era.Contains(holiday.Date{Year: year, Month: time.Month(month), Day: day}) {
+				return 0, 0, 0, errors.New("out of the era")
+			}
+		case month != 0:
+			first := holiday.Date{Year: year, Month: time.Month(month), Day: 1}
+			last := first.AddDays(31)
+			last = last.AddDays(-last.Day)
+			if !era.Contains(first) && !era.Contains(last) {
+				return 0, 0, 0, errors.New("out of the era")
+			}
+		}
+	}
 	return
 }
 
+// parseYear parses a year in the Gregorian calendar, such as "2006",
+// or a year in the Japanese calendar, such as "R7".
+func parseYear(s string) (int, holiday.Era, error) {
+	if len(s) == 0 || s[0] < 'A' || s[0] > 'Z' {
+		year, err := parseInt(s, 4)
+		return year, holiday.EraNone, err
+	}
+
+	era, ok := holiday.ParseEra(s[:1])
+	if !ok {
+		return 0, holiday.EraNone, fmt.Errorf("unknown era: %s", s[:1])
+	}
+	var n int
+	var err error
+	switch len(s) {
+	case 2:
+		n, err = parseInt(s[1:], 1)
+	case 3:
+		n, err = parseInt(s[1:], 2)
+	default:
+		err = errors.New("invalid format")
+	}
+	if err != nil {
+		return 0, holiday.EraNone, err
+	}
+	year, ok := era.GregorianYear(n)
+	if !ok {
+		return 0, holiday.EraNone, errors.New("out of the era")
+	}
+	return year, era, nil
+}
+
 func parseInt(s string, digits int) (int, error) {
 	if len(s) != digits {
 		return 0, errors.New("invalid format")
@@ -166,7 +300,7 @@ func parseInt(s string, digits int) (int, error) {
 	return ret, nil
 }
 
-func (h *Handler) holiday(w http.ResponseWriter, year int, month time.Month, day int) {
+func (h *Handler) holiday(w http.ResponseWriter, cal *holiday.Calendar, year int, month time.Month, day int, lang holiday.Language) {
 	now := time.Now().In(jst)
 	if year < now.Year() || (year == now.Year() && month < now.Month()) || (year == now.Year() && month == now.Month() && day < now.Day()) {
 		w.Header().Set("Cache-Control", fmt.Sprintf("max-age=%d", 365*24*60*60))
@@ -174,15 +308,15 @@ func (h *Handler) holiday(w http.ResponseWriter, year int, month time.Month, day
 		w.Header().Set("Cache-Control", fmt.Sprintf("max-age=%d", 24*60*60))
 	}
 
-	d, ok := holiday.FindHoliday(year, month, day)
+	d, ok := cal.FindHoliday(year, month, day)
 	if ok {
-		h.responseHolidays(w, []holiday.Holiday{d})
+		h.responseHolidays(w, []holiday.Holiday{d}, lang)
 	} else {
-		h.responseHolidays(w, []holiday.Holiday{})
+		h.responseHolidays(w, []holiday.Holiday{}, lang)
 	}
 }
 
-func (h *Handler) holidaysInMonth(w http.ResponseWriter, year int, month time.Month) {
+func (h *Handler) holidaysInMonth(w http.ResponseWriter, cal *holiday.Calendar, year int, month time.Month, lang holiday.Language) {
 	now := time.Now().In(jst)
 	if year < now.Year() || (year == now.Year() && month < now.Month()) {
 		w.Header().Set("Cache-Control", fmt.Sprintf("max-age=%d", 365*24*60*60))
@@ -190,61 +324,138 @@ func (h *Handler) holidaysInMonth(w http.ResponseWriter, year int, month time.Mo
 		w.Header().Set("Cache-Control", fmt.Sprintf("max-age=%d", 24*60*60))
 	}
 
-	holidays := holiday.FindHolidaysInMonth(year, month)
-	h.responseHolidays(w, holidays)
+	holidays := cal.FindHolidaysInMonth(year, month)
+	h.responseHolidays(w, holidays, lang)
+}
+
+func (h *Handler) holidaysInYear(w http.ResponseWriter, cal *holiday.Calendar, year int, lang holiday.Language, detail bool) {
+	h.setCacheControlForYear(w, year)
+
+	holidays := cal.FindHolidaysInYear(year)
+	if detail {
+		h.responseHolidaysWithDetail(w, cal, holidays, lang)
+		return
+	}
+	h.responseHolidays(w, holidays, lang)
 }
 
-func (h *Handler) holidaysInYear(w http.ResponseWriter, year int) {
+// setCacheControlForYear sets the Cache-Control header for the response that contains holidays in the year.
+// The holidays in the past years are never changed, so they can be cached for a long time.
+func (h *Handler) setCacheControlForYear(w http.ResponseWriter, year int) {
 	now := time.Now().In(jst)
 	if year < now.Year() {
 		w.Header().Set("Cache-Control", fmt.Sprintf("max-age=%d", 365*24*60*60))
 	} else {
 		w.Header().Set("Cache-Control", fmt.Sprintf("max-age=%d", 24*60*60))
 	}
-
-	holidays := holiday.FindHolidaysInYear(year)
-	h.responseHolidays(w, holidays)
 }
 
-func (h *Handler) holidaysInRange(w http.ResponseWriter, u *url.URL) error {
+func (h *Handler) holidaysInRange(w http.ResponseWriter, cal *holiday.Calendar, u *url.URL, lang holiday.Language) error {
 	w.Header().Set("Cache-Control", fmt.Sprintf("max-age=%d", 24*60*60))
 
 	q := u.Query()
 	if !q.Has("from") || !q.Has("to") {
-		h.holidaysInYear(w, time.Now().In(jst).Year())
+		h.holidaysInYear(w, cal, time.Now().In(jst).Year(), lang, false)
 		return nil
 	}
-	from, err := parseDate(q.Get("from"))
+	from, err := holiday.ParseDate(q.Get("from"))
 	if err != nil {
 		return err
 	}
-	to, err := parseDate(q.Get("to"))
+	to, err := holiday.ParseDate(q.Get("to"))
 	if err != nil {
 		return err
 	}
 
-	holidays := holiday.FindHolidaysInRange(from, to)
-	h.responseHolidays(w, holidays)
+	holidays := cal.FindHolidaysInRange(from, to)
+	h.responseHolidays(w, holidays, lang)
 	return nil
 }
 
-func (h *Handler) responseHolidays(w http.ResponseWriter, holidays []holiday.Holiday) {
-	w.Header().Set("Content-Type", "application/json")
-	w.Header().Set("Link", "<https://github.com/sponsors/shogo82148>; rel=\"author\"")
+func (h *Handler) nextHoliday(w http.ResponseWriter, cal *holiday.Calendar, u *url.URL, lang holiday.Language) error {
+	date, err := h.dateFromQuery(w, u)
+	if err != nil {
+		return err
+	}
 
-	// ref. https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Strict-Transport-Security#examples
-	w.Header().Set("Strict-Transport-Security", "max-age=63072000")
+	d, ok := cal.NextHoliday(date)
+	if ok {
+		h.responseHolidays(w, []holiday.Holiday{d}, lang)
+	} else {
+		h.responseHolidays(w, []holiday.Holiday{}, lang)
+	}
+	return nil
+}
+
+func (h *Handler) previousHoliday(w http.ResponseWriter, cal *holiday.Calendar, u *url.URL, lang holiday.Language) error {
+	date, err := h.dateFromQuery(w, u)
+	if err != nil {
+		return err
+	}
+
+	d, ok := cal.PreviousHoliday(date)
+	if ok {
+		h.responseHolidays(w, []holiday.Holiday{d}, lang)
+	} else {
+		h.responseHolidays(w, []holiday.Holiday{}, lang)
+	}
+	return nil
+}
+
+// dateFromQuery returns the date specified by the date query parameter.
+// If it is omitted, it returns today in JST,
+// and the response is cached until the end of today.
+func (h *Handler) dateFromQuery(w http.ResponseWriter, u *url.URL) (holiday.Date, error) {
+	q := u.Query()
+	if q.Has("date") {
+		w.Header().Set("Cache-Control", fmt.Sprintf("max-age=%d", 24*60*60))
+		return holiday.ParseDate(q.Get("date"))
+	}
+
+	now := time.Now().In(jst)
+	tomorrow := time.Date(now.Year(), now.Month(), now.Day()+1, 0, 0, 0, 0, jst)
+	w.Header().Set("Cache-Control", fmt.Sprintf("max-age=%d", int(tomorrow.Sub(now).Seconds())))
+	return holiday.FromTime(now), nil
+}
+
+func (h *Handler) responseHolidays(w http.ResponseWriter, holidays []holiday.Holiday, lang holiday.Language) {
+	w.Header().Set("Content-Language", string(lang))
+	w.Header().Set("Vary", "Accept-Language")
 
 	res := make([]Holiday, 0, len(holidays))
 	for _, d := range holidays {
-		res = append(res, Holiday{
-			Date: d.Date,
-			Name: d.Name,
-		})
+		res = append(res, newHoliday(d, lang))
 	}
-	data, err := json.Marshal(Response{
+	h.responseJSON(w, Response{
 		Holidays: res,
 	})
+}
+
+func newHoliday(d holiday.Holiday, lang holiday.Language) Holiday {
+	ret := Holiday{
+		Date:     d.Date,
+		Name:     d.LocalizedName(lang),
+		NameKana: d.Kana,
+		Kind:     d.Kind.String(),
+		ID:       d.ID,
+	}
+	if date, err := holiday.ParseDate(d.Date); err == nil {
+		if jd, ok := date.Japanese(); ok {
+			ret.Era = jd.Era.LocalizedName(lang)
+			ret.EraYear = jd.Year
+		}
+	}
+	return ret
+}
+
+func (h *Handler) responseJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Link", "<https://github.com/sponsors/shogo82148>; rel=\"author\"")
+
+	// ref. https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Strict-Transport-Security#examples
+	w.Header().Set("Strict-Transport-Security", "max-age=63072000")
+
+	data, err := json.Marshal(v)
 	if err != nil {
 		log.Printf("failed to marshal response: %v", err)
 		w.WriteHeader(http.StatusInternalServerError)
@@ -257,6 +468,28 @@ func (h *Handler) responseHolidays(w http.ResponseWriter, holidays []holiday.Hol
 	w.Write(data)
 }
 
+func (h *Handler) responseBadRequest(w http.ResponseWriter, message string) {
+	w.Header().Set("Cache-Control", fmt.Sprintf("max-age=%d", 24*60*60))
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Link", "<https://github.com/sponsors/shogo82148>; rel=\"author\"")
+
+	// ref. https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Strict-Transport-Security#examples
+	w.Header().Set("Strict-Transport-Security", "max-age=63072000")
+
+	data, err := json.Marshal(map[string]string{
+		"error":   "bad request",
+		"message": message,
+	})
+	if err != nil {
+		log.Printf("failed to marshal response: %v", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		io.WriteString(w, `{"error":"internal server error"}`)
+		return
+	}
+	w.WriteHeader(http.StatusBadRequest)
+	w.Write(data)
+}
+
 func (h *Handler) responseNotFound(w http.ResponseWriter) {
 	w.Header().Set("Cache-Control", fmt.Sprintf("max-age=%d", 24*60*60))
 	w.Header().Set("Content-Type", "application/json")
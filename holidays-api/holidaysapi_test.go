@@ -46,7 +46,7 @@ func TestServeHTTP(t *testing.T) {
 	})
 
 	t.Run("range", func(t *testing.T) {
-		req := httptest.NewRequest(http.MethodGet, "http://example.com/holidays?from=2000-01-01&to=2000-06-31", nil)
+		req := httptest.NewRequest(http.MethodGet, "http://example.com/holidays?from=2000-01-01&to=2000-06-30", nil)
 		w := httptest.NewRecorder()
 		h.ServeHTTP(w, req)
 
@@ -68,36 +68,76 @@ func TestServeHTTP(t *testing.T) {
 		want := Response{
 			Holidays: []Holiday{
 				{
-					Date: "2000-01-01",
-					Name: "元日",
+					Date:     "2000-01-01",
+					Name:     "元日",
+					NameKana: "がんじつ",
+					Kind:     "national",
+					ID:       "new-years-day",
+					Era:      "平成",
+					EraYear:  12,
 				},
 				{
-					Date: "2000-01-10",
-					Name: "成人の日",
+					Date:     "2000-01-10",
+					Name:     "成人の日",
+					NameKana: "せいじんのひ",
+					Kind:     "national",
+					ID:       "coming-of-age-day",
+					Era:      "平成",
+					EraYear:  12,
 				},
 				{
-					Date: "2000-02-11",
-					Name: "建国記念の日",
+					Date:     "2000-02-11",
+					Name:     "建国記念の日",
+					NameKana: "けんこくきねんのひ",
+					Kind:     "national",
+					ID:       "national-foundation-day",
+					Era:      "平成",
+					EraYear:  12,
 				},
 				{
-					Date: "2000-03-20",
-					Name: "春分の日",
+					Date:     "2000-03-20",
+					Name:     "春分の日",
+					NameKana: "しゅんぶんのひ",
+					Kind:     "national",
+					ID:       "vernal-equinox-day",
+					Era:      "平成",
+					EraYear:  12,
 				},
 				{
-					Date: "2000-04-29",
-					Name: "みどりの日",
+					Date:     "2000-04-29",
+					Name:     "みどりの日",
+					NameKana: "みどりのひ",
+					Kind:     "national",
+					ID:       "greenery-day",
+					Era:      "平成",
+					EraYear:  12,
 				},
 				{
-					Date: "2000-05-03",
-					Name: "憲法記念日",
+					Date:     "2000-05-03",
+					Name:     "憲法記念日",
+					NameKana: "けんぽうきねんび",
+					Kind:     "national",
+					ID:       "constitution-memorial-day",
+					Era:      "平成",
+					EraYear:  12,
 				},
 				{
-					Date: "2000-05-04",
-					Name: "休日",
+					Date:     "2000-05-04",
+					Name:     "休日",
+					NameKana: "きゅうじつ",
+					Kind:     "citizens",
+					ID:       "citizens-holiday",
+					Era:      "平成",
+					EraYear:  12,
 				},
 				{
-					Date: "2000-05-05",
-					Name: "こどもの日",
+					Date:     "2000-05-05",
+					Name:     "こどもの日",
+					NameKana: "こどものひ",
+					Kind:     "national",
+					ID:       "childrens-day",
+					Era:      "平成",
+					EraYear:  12,
 				},
 			},
 		}
@@ -129,64 +169,139 @@ func TestServeHTTP(t *testing.T) {
 		want := Response{
 			Holidays: []Holiday{
 				{
-					Date: "2000-01-01",
-					Name: "元日",
+					Date:     "2000-01-01",
+					Name:     "元日",
+					NameKana: "がんじつ",
+					Kind:     "national",
+					ID:       "new-years-day",
+					Era:      "平成",
+					EraYear:  12,
 				},
 				{
-					Date: "2000-01-10",
-					Name: "成人の日",
+					Date:     "2000-01-10",
+					Name:     "成人の日",
+					NameKana: "せいじんのひ",
+					Kind:     "national",
+					ID:       "coming-of-age-day",
+					Era:      "平成",
+					EraYear:  12,
 				},
 				{
-					Date: "2000-02-11",
-					Name: "建国記念の日",
+					Date:     "2000-02-11",
+					Name:     "建国記念の日",
+					NameKana: "けんこくきねんのひ",
+					Kind:     "national",
+					ID:       "national-foundation-day",
+					Era:      "平成",
+					EraYear:  12,
 				},
 				{
-					Date: "2000-03-20",
-					Name: "春分の日",
+					Date:     "2000-03-20",
+					Name:     "春分の日",
+					NameKana: "しゅんぶんのひ",
+					Kind:     "national",
+					ID:       "vernal-equinox-day",
+					Era:      "平成",
+					EraYear:  12,
 				},
 				{
-					Date: "2000-04-29",
-					Name: "みどりの日",
+					Date:     "2000-04-29",
+					Name:     "みどりの日",
+					NameKana: "みどりのひ",
+					Kind:     "national",
+					ID:       "greenery-day",
+					Era:      "平成",
+					EraYear:  12,
 				},
 				{
-					Date: "2000-05-03",
-					Name: "憲法記念日",
+					Date:     "2000-05-03",
+					Name:     "憲法記念日",
+					NameKana: "けんぽうきねんび",
+					Kind:     "national",
+					ID:       "constitution-memorial-day",
+					Era:      "平成",
+					EraYear:  12,
 				},
 				{
-					Date: "2000-05-04",
-					Name: "休日",
+					Date:     "2000-05-04",
+					Name:     "休日",
+					NameKana: "きゅうじつ",
+					Kind:     "citizens",
+					ID:       "citizens-holiday",
+					Era:      "平成",
+					EraYear:  12,
 				},
 				{
-					Date: "2000-05-05",
-					Name: "こどもの日",
+					Date:     "2000-05-05",
+					Name:     "こどもの日",
+					NameKana: "こどものひ",
+					Kind:     "national",
+					ID:       "childrens-day",
+					Era:      "平成",
+					EraYear:  12,
 				},
 				{
-					Date: "2000-07-20",
-					Name: "海の日",
+					Date:     "2000-07-20",
+					Name:     "海の日",
+					NameKana: "うみのひ",
+					Kind:     "national",
+					ID:       "marine-day",
+					Era:      "平成",
+					EraYear:  12,
 				},
 				{
-					Date: "2000-09-15",
-					Name: "敬老の日",
+					Date:     "2000-09-15",
+					Name:     "敬老の日",
+					NameKana: "けいろうのひ",
+					Kind:     "national",
+					ID:       "respect-for-the-aged-day",
+					Era:      "平成",
+					EraYear:  12,
 				},
 				{
-					Date: "2000-09-23",
-					Name: "秋分の日",
+					Date:     "2000-09-23",
+					Name:     "秋分の日",
+					NameKana: "しゅうぶんのひ",
+					Kind:     "national",
+					ID:       "autumnal-equinox-day",
+					Era:      "平成",
+					EraYear:  12,
 				},
 				{
-					Date: "2000-10-09",
-					Name: "体育の日",
+					Date:     "2000-10-09",
+					Name:     "体育の日",
+					NameKana: "たいいくのひ",
+					Kind:     "national",
+					ID:       "sports-day",
+					Era:      "平成",
+					EraYear:  12,
 				},
 				{
-					Date: "2000-11-03",
-					Name: "文化の日",
+					Date:     "2000-11-03",
+					Name:     "文化の日",
+					NameKana: "ぶんかのひ",
+					Kind:     "national",
+					ID:       "culture-day",
+					Era:      "平成",
+					EraYear:  12,
 				},
 				{
-					Date: "2000-11-23",
-					Name: "勤労感謝の日",
+					Date:     "2000-11-23",
+					Name:     "勤労感謝の日",
+					NameKana: "きんろうかんしゃのひ",
+					Kind:     "national",
+					ID:       "labor-thanksgiving-day",
+					Era:      "平成",
+					EraYear:  12,
 				},
 				{
-					Date: "2000-12-23",
-					Name: "天皇誕生日",
+					Date:     "2000-12-23",
+					Name:     "天皇誕生日",
+					NameKana: "てんのうたんじょうび",
+					Kind:     "national",
+					ID:       "emperors-birthday",
+					Era:      "平成",
+					EraYear:  12,
 				},
 			},
 		}
@@ -218,12 +333,22 @@ func TestServeHTTP(t *testing.T) {
 		want := Response{
 			Holidays: []Holiday{
 				{
-					Date: "2000-01-01",
-					Name: "元日",
+					Date:     "2000-01-01",
+					Name:     "元日",
+					NameKana: "がんじつ",
+					Kind:     "national",
+					ID:       "new-years-day",
+					Era:      "平成",
+					EraYear:  12,
 				},
 				{
-					Date: "2000-01-10",
-					Name: "成人の日",
+					Date:     "2000-01-10",
+					Name:     "成人の日",
+					NameKana: "せいじんのひ",
+					Kind:     "national",
+					ID:       "coming-of-age-day",
+					Era:      "平成",
+					EraYear:  12,
 				},
 			},
 		}
@@ -255,8 +380,13 @@ func TestServeHTTP(t *testing.T) {
 		want := Response{
 			Holidays: []Holiday{
 				{
-					Date: "2000-01-01",
-					Name: "元日",
+					Date:     "2000-01-01",
+					Name:     "元日",
+					NameKana: "がんじつ",
+					Kind:     "national",
+					ID:       "new-years-day",
+					Era:      "平成",
+					EraYear:  12,
 				},
 			},
 		}
@@ -266,6 +396,186 @@ func TestServeHTTP(t *testing.T) {
 	})
 }
 
+func TestServeHTTP_NextAndPrevious(t *testing.T) {
+	h := NewHandler()
+	tests := []struct {
+		url  string
+		want Response
+	}{
+		{
+			url: "http://example.com/next?date=2000-01-01",
+			want: Response{
+				Holidays: []Holiday{
+					{
+						Date:     "2000-01-10",
+						Name:     "成人の日",
+						NameKana: "せいじんのひ",
+						Kind:     "national",
+						ID:       "coming-of-age-day",
+						Era:      "平成",
+						EraYear:  12,
+					},
+				},
+			},
+		},
+		{
+			url: "http://example.com/previous?date=2000-01-10",
+			want: Response{
+				Holidays: []Holiday{
+					{
+						Date:     "2000-01-01",
+						Name:     "元日",
+						NameKana: "がんじつ",
+						Kind:     "national",
+						ID:       "new-years-day",
+						Era:      "平成",
+						EraYear:  12,
+					},
+				},
+			},
+		},
+		{
+			url: "http://example.com/previous?date=1900-01-01",
+			want: Response{
+				Holidays: []Holiday{},
+			},
+		},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodGet, tt.url, nil)
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+
+		resp := w.Result()
+		if resp.StatusCode != http.StatusOK {
+			t.Errorf("%s: unexpected status code: want %d, got %d", tt.url, http.StatusOK, resp.StatusCode)
+		}
+		if resp.Header.Get("Cache-Control") == "" {
+			t.Errorf("%s: Cache-Control is not set", tt.url)
+		}
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got Response
+		if err := json.Unmarshal(body, &got); err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(tt.want, got); diff != "" {
+			t.Errorf("%s: unexpected response: (-want/+got)\n%s", tt.url, diff)
+		}
+	}
+
+	t.Run("today", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "http://example.com/next", nil)
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+
+		resp := w.Result()
+		if resp.StatusCode != http.StatusOK {
+			t.Errorf("unexpected status code: want %d, got %d", http.StatusOK, resp.StatusCode)
+		}
+		if resp.Header.Get("Cache-Control") == "" {
+			t.Error("Cache-Control is not set")
+		}
+	})
+
+	t.Run("invalid date", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "http://example.com/next?date=2000-1-1", nil)
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+
+		resp := w.Result()
+		if resp.StatusCode != http.StatusNotFound {
+			t.Errorf("unexpected status code: want %d, got %d", http.StatusNotFound, resp.StatusCode)
+		}
+	})
+}
+
+func TestServeHTTP_Language(t *testing.T) {
+	h := NewHandler()
+	req := httptest.NewRequest(http.MethodGet, "http://example.com/2000/01?lang=en", nil)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+
+	resp := w.Result()
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("unexpected status code: want %d, got %d", http.StatusOK, resp.StatusCode)
+	}
+	if got, want := resp.Header.Get("Content-Language"), "en"; got != want {
+		t.Errorf("unexpected Content-Language: want %q, got %q", want, got)
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got Response
+	if err := json.Unmarshal(body, &got); err != nil {
+		t.Fatal(err)
+	}
+	want := Response{
+		Holidays: []Holiday{
+			{
+				Date:     "2000-01-01",
+				Name:     "New Year's Day",
+				NameKana: "がんじつ",
+				Kind:     "national",
+				ID:       "new-years-day",
+				Era:      "Heisei",
+				EraYear:  12,
+			},
+			{
+				Date:     "2000-01-10",
+				Name:     "Coming of Age Day",
+				NameKana: "せいじんのひ",
+				Kind:     "national",
+				ID:       "coming-of-age-day",
+				Era:      "Heisei",
+				EraYear:  12,
+			},
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("unexpected response: (-want/+got)\n%s", diff)
+	}
+}
+
+func TestServeHTTP_Era(t *testing.T) {
+	h := NewHandler()
+	req := httptest.NewRequest(http.MethodGet, "http://example.com/R7/05/03", nil)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+
+	resp := w.Result()
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("unexpected status code: want %d, got %d", http.StatusOK, resp.StatusCode)
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got Response
+	if err := json.Unmarshal(body, &got); err != nil {
+		t.Fatal(err)
+	}
+	want := Response{
+		Holidays: []Holiday{
+			{
+				Date:     "2025-05-03",
+				Name:     "憲法記念日",
+				NameKana: "けんぽうきねんび",
+				Kind:     "national",
+				ID:       "constitution-memorial-day",
+				Era:      "令和",
+				EraYear:  7,
+			},
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("unexpected response: (-want/+got)\n%s", diff)
+	}
+}
+
 func TestParsePath(t *testing.T) {
 	tests := []struct {
 		path  string
@@ -321,6 +631,61 @@ func TestParsePath(t *testing.T) {
 			path: "2006/01/02/03",
 			err:  true,
 		},
+		{
+			path: "R7",
+			year: 2025,
+		},
+		{
+			path:  "R07/05/03",
+			year:  2025,
+			month: 5,
+			day:   3,
+		},
+		{
+			path:  "R1/05",
+			year:  2019,
+			month: 5,
+		},
+		{
+			path: "R1/04",
+			err:  true,
+		},
+		{
+			path:  "H31/04/30",
+			year:  2019,
+			month: 4,
+			day:   30,
+		},
+		{
+			path: "H31/05/01",
+			err:  true,
+		},
+		{
+			path:  "S64/01/07",
+			year:  1989,
+			month: 1,
+			day:   7,
+		},
+		{
+			path: "S64/01/08",
+			err:  true,
+		},
+		{
+			path: "S65",
+			err:  true,
+		},
+		{
+			path: "R0",
+			err:  true,
+		},
+		{
+			path: "X7",
+			err:  true,
+		},
+		{
+			path: "R123",
+			err:  true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -339,21 +704,3 @@ func TestParsePath(t *testing.T) {
 		}
 	}
 }
-
-func FuzzParseDate(f *testing.F) {
-	f.Add("2006-01-02")
-	f.Fuzz(func(t *testing.T, date string) {
-		d0, err := parseDate(date)
-		if err != nil {
-			return
-		}
-		s0 := d0.String()
-		d1, err := parseDate(s0)
-		if err != nil {
-			t.Fatal(err)
-		}
-		if d0 != d1 {
-			t.Errorf("unexpected date: want %s, got %s", d0, d1)
-		}
-	})
-}
@@ -0,0 +1,150 @@
+package holidaysapi
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/shogo82148/holidays-jp/holidays-api/holiday"
+)
+
+// the domain name used for the UID of events.
+const icalDomain = "holidays-jp.shogo82148.com"
+
+// calendarNames are the names of the calendar in each language.
+var calendarNames = map[holiday.Language]string{
+	holiday.Japanese: "日本の祝日",
+	holiday.English:  "Holidays in Japan",
+	holiday.Romaji:   "Nihon no Shukujitsu",
+}
+
+func (h *Handler) icalInYear(w http.ResponseWriter, cal *holiday.Calendar, year int, lang holiday.Language) {
+	h.setCacheControlForYear(w, year)
+
+	holidays := cal.FindHolidaysInYear(year)
+	h.responseICalendar(w, holidays, lang)
+}
+
+func (h *Handler) icalInRange(w http.ResponseWriter, cal *holiday.Calendar, u *url.URL, lang holiday.Language) error {
+	w.Header().Set("Cache-Control", fmt.Sprintf("max-age=%d", 24*60*60))
+
+	q := u.Query()
+	if !q.Has("from") || !q.Has("to") {
+		h.icalInYear(w, cal, time.Now().In(jst).Year(), lang)
+		return nil
+	}
+	from, err := holiday.ParseDate(q.Get("from"))
+	if err != nil {
+		return err
+	}
+	to, err := holiday.ParseDate(q.Get("to"))
+	if err != nil {
+		return err
+	}
+
+	holidays := cal.FindHolidaysInRange(from, to)
+	h.responseICalendar(w, holidays, lang)
+	return nil
+}
+
+func (h *Handler) responseICalendar(w http.ResponseWriter, holidays []holiday.Holiday, lang holiday.Language) {
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	w.Header().Set("Content-Language", string(lang))
+	w.Header().Set("Vary", "Accept-Language")
+	w.Header().Set("Link", "<https://github.com/sponsors/shogo82148>; rel=\"author\"")
+
+	// ref. https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Strict-Transport-Security#examples
+	w.Header().Set("Strict-Transport-Security", "max-age=63072000")
+
+	var buf bytes.Buffer
+	writeICalendar(&buf, holidays, lang, time.Now())
+
+	w.Header().Set("Content-Length", strconv.Itoa(buf.Len()))
+	w.WriteHeader(http.StatusOK)
+	w.Write(buf.Bytes())
+}
+
+// writeICalendar writes holidays in the iCalendar format.
+// ref. RFC 5545 Internet Calendaring and Scheduling Core Object Specification (iCalendar)
+func writeICalendar(w io.Writer, holidays []holiday.Holiday, lang holiday.Language, now time.Time) {
+	stamp := now.UTC().Format("20060102T150405Z")
+
+	writeICalendarLine(w, "BEGIN:VCALENDAR")
+	writeICalendarLine(w, "VERSION:2.0")
+	writeICalendarLine(w, "PRODID:-//shogo82148//holidays-jp//JA")
+	writeICalendarLine(w, "CALSCALE:GREGORIAN")
+	writeICalendarLine(w, "METHOD:PUBLISH")
+	writeICalendarLine(w, "X-WR-CALNAME:"+escapeICalendarText(calendarNames[lang]))
+	writeICalendarLine(w, "X-WR-TIMEZONE:Asia/Tokyo")
+	for _, d := range holidays {
+		date, err := holiday.ParseDate(d.Date)
+		if err != nil {
+			// the holiday package always returns valid dates.
+			panic(err)
+		}
+		start := date.Time(time.UTC)
+		end := date.AddDays(1).Time(time.UTC)
+
+		writeICalendarLine(w, "BEGIN:VEVENT")
+		writeICalendarLine(w, "UID:"+icalendarUID(d))
+		writeICalendarLine(w, "DTSTAMP:"+stamp)
+		writeICalendarLine(w, "DTSTART;VALUE=DATE:"+start.Format("20060102"))
+		writeICalendarLine(w, "DTEND;VALUE=DATE:"+end.Format("20060102"))
+		writeICalendarLine(w, "SUMMARY:"+escapeICalendarText(d.LocalizedName(lang)))
+		writeICalendarLine(w, "CATEGORIES:"+escapeICalendarText(d.Kind.String()))
+		writeICalendarLine(w, "TRANSP:TRANSPARENT")
+		writeICalendarLine(w, "END:VEVENT")
+	}
+	writeICalendarLine(w, "END:VCALENDAR")
+}
+
+// icalendarUID returns the unique identifier of the holiday.
+// It is stable as long as the date and the name of the holiday are not changed.
+func icalendarUID(d holiday.Holiday) string {
+	sum := sha256.Sum256([]byte(d.Name))
+	return strings.ReplaceAll(d.Date, "-", "") + "-" + hex.EncodeToString(sum[:8]) + "@" + icalDomain
+}
+
+// escapeICalendarText escapes s as a TEXT value.
+// ref. RFC 5545 3.3.11. Text
+func escapeICalendarText(s string) string {
+	r := strings.NewReplacer(
+		`\`, `\\`,
+		`;`, `\;`,
+		`,`, `\,`,
+		"\n", `\n`,
+	)
+	return r.Replace(s)
+}
+
+// writeICalendarLine writes a content line.
+// Lines longer than 75 octets are folded.
+// ref. RFC 5545 3.1. Content Lines
+func writeICalendarLine(w io.Writer, line string) {
+	const maxOctets = 75
+
+	limit := maxOctets
+	for len(line) > limit {
+		// don't split a multi-octet UTF-8 sequence.
+		i := limit
+		for i > 0 && !utf8.RuneStart(line[i]) {
+			i--
+		}
+		io.WriteString(w, line[:i])
+		io.WriteString(w, "\r\n ")
+		line = line[i:]
+
+		// the leading space of the continuation line is counted.
+		limit = maxOctets - 1
+	}
+	io.WriteString(w, line)
+	io.WriteString(w, "\r\n")
+}
@@ -0,0 +1,141 @@
+package holidaysapi
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/shogo82148/holidays-jp/holidays-api/holiday"
+)
+
+func TestServeHTTP_ICalendar(t *testing.T) {
+	h := NewHandler()
+	t.Run("year", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "http://example.com/2000.ics", nil)
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+
+		resp := w.Result()
+		if resp.StatusCode != http.StatusOK {
+			t.Errorf("unexpected status code: want %d, got %d", http.StatusOK, resp.StatusCode)
+		}
+		if got, want := resp.Header.Get("Content-Type"), "text/calendar; charset=utf-8"; got != want {
+			t.Errorf("unexpected Content-Type: want %q, got %q", want, got)
+		}
+		if resp.Header.Get("Cache-Control") == "" {
+			t.Error("Cache-Control is not set")
+		}
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := bytes.Count(body, []byte("BEGIN:VEVENT\r\n")), 15; got != want {
+			t.Errorf("unexpected number of events: want %d, got %d", want, got)
+		}
+	})
+
+	t.Run("range", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "http://example.com/holidays.ics?from=2000-01-01&to=2000-01-31", nil)
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+
+		resp := w.Result()
+		if resp.StatusCode != http.StatusOK {
+			t.Errorf("unexpected status code: want %d, got %d", http.StatusOK, resp.StatusCode)
+		}
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := bytes.Count(body, []byte("BEGIN:VEVENT\r\n")), 2; got != want {
+			t.Errorf("unexpected number of events: want %d, got %d", want, got)
+		}
+	})
+
+	t.Run("invalid year", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "http://example.com/200.ics", nil)
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+
+		resp := w.Result()
+		if resp.StatusCode != http.StatusNotFound {
+			t.Errorf("unexpected status code: want %d, got %d", http.StatusNotFound, resp.StatusCode)
+		}
+	})
+}
+
+func TestWriteICalendar(t *testing.T) {
+	// SHA-256 of "元日"
+	const uidHash = "e62e68948b9722ca"
+
+	var buf bytes.Buffer
+	now := time.Date(2006, time.January, 2, 15, 4, 5, 0, time.UTC)
+	writeICalendar(&buf, []holiday.Holiday{
+		{
+			Date: "2000-12-31",
+			Name: "元日",
+		},
+	}, holiday.Japanese, now)
+
+	want := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:-//shogo82148//holidays-jp//JA",
+		"CALSCALE:GREGORIAN",
+		"METHOD:PUBLISH",
+		"X-WR-CALNAME:日本の祝日",
+		"X-WR-TIMEZONE:Asia/Tokyo",
+		"BEGIN:VEVENT",
+		"UID:20001231-" + uidHash + "@holidays-jp.shogo82148.com",
+		"DTSTAMP:20060102T150405Z",
+		"DTSTART;VALUE=DATE:20001231",
+		"DTEND;VALUE=DATE:20010101",
+		"SUMMARY:元日",
+		"CATEGORIES:national",
+		"TRANSP:TRANSPARENT",
+		"END:VEVENT",
+		"END:VCALENDAR",
+		"",
+	}, "\r\n")
+	if diff := cmp.Diff(want, buf.String()); diff != "" {
+		t.Errorf("unexpected output: (-want/+got)\n%s", diff)
+	}
+}
+
+func TestICalendarUID(t *testing.T) {
+	a := icalendarUID(holiday.Holiday{Date: "2000-01-01", Name: "元日"})
+	b := icalendarUID(holiday.Holiday{Date: "2000-01-01", Name: "元日"})
+	c := icalendarUID(holiday.Holiday{Date: "2000-01-01", Name: "休日"})
+	if a != b {
+		t.Errorf("UID is not stable: %q and %q", a, b)
+	}
+	if a == c {
+		t.Errorf("UID must depend on the name: %q", a)
+	}
+}
+
+func TestWriteICalendarLine(t *testing.T) {
+	var buf bytes.Buffer
+	writeICalendarLine(&buf, "SUMMARY:"+strings.Repeat("あ", 30))
+
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\r\n"), "\r\n")
+	if len(lines) != 2 {
+		t.Fatalf("want 2 lines, got %d: %q", len(lines), lines)
+	}
+	for _, line := range lines {
+		if len(line) > 75 {
+			t.Errorf("line too long: %q", line)
+		}
+	}
+	if !strings.HasPrefix(lines[1], " ") {
+		t.Errorf("continuation line must start with a space: %q", lines[1])
+	}
+	if got, want := lines[0]+lines[1][1:], "SUMMARY:"+strings.Repeat("あ", 30); got != want {
+		t.Errorf("want %q, got %q", want, got)
+	}
+}
@@ -0,0 +1,92 @@
+package holidaysapi
+
+import (
+	"cmp"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/shogo82148/holidays-jp/holidays-api/holiday"
+)
+
+// negotiateLanguage returns the language of holiday names for the request.
+// The lang query parameter takes precedence over the Accept-Language header.
+// It falls back to Japanese.
+func negotiateLanguage(r *http.Request) holiday.Language {
+	if lang := r.URL.Query().Get("lang"); lang != "" {
+		if l, ok := matchLanguage(lang); ok {
+			return l
+		}
+		return holiday.Japanese
+	}
+
+	for _, tag := range parseAcceptLanguage(r.Header.Get("Accept-Language")) {
+		if l, ok := matchLanguage(tag); ok {
+			return l
+		}
+	}
+	return holiday.Japanese
+}
+
+// matchLanguage returns the supported language that matches the language tag.
+func matchLanguage(tag string) (holiday.Language, bool) {
+	tag = strings.ToLower(tag)
+	switch {
+	case tag == "ja-latn" || strings.HasPrefix(tag, "ja-latn-"):
+		return holiday.Romaji, true
+	case tag == "ja" || strings.HasPrefix(tag, "ja-"):
+		return holiday.Japanese, true
+	case tag == "en" || strings.HasPrefix(tag, "en-"):
+		return holiday.English, true
+	case tag == "*":
+		return holiday.Japanese, true
+	}
+	return "", false
+}
+
+// parseAcceptLanguage parses the Accept-Language header,
+// and returns the language tags in order of preference.
+// ref. RFC 9110 12.5.4. Accept-Language
+func parseAcceptLanguage(s string) []string {
+	type weighted struct {
+		tag string
+		q   float64
+	}
+
+	var tags []weighted
+	for item := range strings.SplitSeq(s, ",") {
+		tag, params, _ := strings.Cut(item, ";")
+		tag = strings.TrimSpace(tag)
+		if tag == "" {
+			continue
+		}
+
+		q := 1.0
+		for param := range strings.SplitSeq(params, ";") {
+			key, value, ok := strings.Cut(strings.TrimSpace(param), "=")
+			if !ok || !strings.EqualFold(key, "q") {
+				continue
+			}
+			v, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				continue
+			}
+			q = v
+		}
+		if q <= 0 {
+			continue
+		}
+		tags = append(tags, weighted{tag: tag, q: q})
+	}
+
+	slices.SortStableFunc(tags, func(a, b weighted) int {
+		return cmp.Compare(b.q, a.q)
+	})
+
+	ret := make([]string, 0, len(tags))
+	for _, t := range tags {
+		ret = append(ret, t.tag)
+	}
+	return ret
+}
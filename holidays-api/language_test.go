@@ -0,0 +1,45 @@
+package holidaysapi
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/shogo82148/holidays-jp/holidays-api/holiday"
+)
+
+func TestNegotiateLanguage(t *testing.T) {
+	tests := []struct {
+		url            string
+		acceptLanguage string
+		want           holiday.Language
+	}{
+		{"/2000", "", holiday.Japanese},
+		{"/2000?lang=en", "", holiday.English},
+		{"/2000?lang=ja-Latn", "", holiday.Romaji},
+		{"/2000?lang=fr", "", holiday.Japanese},
+		{"/2000?lang=ja", "en", holiday.Japanese},
+		{"/2000", "en-US,en;q=0.9,ja;q=0.8", holiday.English},
+		{"/2000", "fr-FR, ja;q=0.5, en;q=0.7", holiday.English},
+		{"/2000", "en;q=0, ja-Latn", holiday.Romaji},
+		{"/2000", "fr", holiday.Japanese},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodGet, "http://example.com"+tt.url, nil)
+		if tt.acceptLanguage != "" {
+			req.Header.Set("Accept-Language", tt.acceptLanguage)
+		}
+		if got := negotiateLanguage(req); got != tt.want {
+			t.Errorf("%q, %q: want %q, got %q", tt.url, tt.acceptLanguage, tt.want, got)
+		}
+	}
+}
+
+func TestParseAcceptLanguage(t *testing.T) {
+	got := parseAcceptLanguage("fr-CH, fr;q=0.9, en;q=0.8, de;q=0.7, *;q=0.5, ja;q=0.8")
+	want := []string{"fr-CH", "fr", "en", "ja", "de", "*"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("unexpected result: (-want/+got)\n%s", diff)
+	}
+}
@@ -0,0 +1,49 @@
+package holidaysapi
+
+import (
+	"net/http"
+
+	"github.com/shogo82148/holidays-jp/holidays-api/holiday"
+)
+
+// LongWeekendsResponse is the response of the long weekends api.
+type LongWeekendsResponse struct {
+	LongWeekends []LongWeekend `json:"long_weekends"`
+}
+
+// LongWeekend is a stretch of consecutive days off (連休).
+type LongWeekend struct {
+	Start string `json:"start"`
+	End   string `json:"end"`
+
+	// Days is the number of days in the stretch.
+	Days int `json:"days"`
+
+	// Holidays are the holidays in the stretch.
+	Holidays []Holiday `json:"holidays"`
+}
+
+func (h *Handler) longWeekends(w http.ResponseWriter, cal *holiday.Calendar, year int, lang holiday.Language) {
+	h.setCacheControlForYear(w, year)
+
+	lws := cal.LongWeekendsInYear(year)
+	res := LongWeekendsResponse{
+		LongWeekends: make([]LongWeekend, 0, len(lws)),
+	}
+	for _, lw := range lws {
+		holidays := make([]Holiday, 0, len(lw.Holidays))
+		for _, d := range lw.Holidays {
+			holidays = append(holidays, newHoliday(d, lang))
+		}
+		res.LongWeekends = append(res.LongWeekends, LongWeekend{
+			Start:    lw.Start.String(),
+			End:      lw.End.String(),
+			Days:     lw.Days(),
+			Holidays: holidays,
+		})
+	}
+
+	w.Header().Set("Content-Language", string(lang))
+	w.Header().Set("Vary", "Accept-Language")
+	h.responseJSON(w, res)
+}
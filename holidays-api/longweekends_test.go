@@ -0,0 +1,53 @@
+package holidaysapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestServeHTTP_LongWeekends(t *testing.T) {
+	h := NewHandler()
+
+	req := httptest.NewRequest(http.MethodGet, "http://example.com/2025/long-weekends?lang=en", nil)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+
+	resp := w.Result()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("unexpected status code: want %d, got %d", http.StatusOK, resp.StatusCode)
+	}
+	if resp.Header.Get("Cache-Control") == "" {
+		t.Error("Cache-Control is not set")
+	}
+
+	var res LongWeekendsResponse
+	if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+		t.Fatal(err)
+	}
+	var gw *LongWeekend
+	for i, lw := range res.LongWeekends {
+		if lw.Start == "2025-05-03" {
+			gw = &res.LongWeekends[i]
+		}
+	}
+	if gw == nil {
+		t.Fatalf("Golden Week is not found: %v", res.LongWeekends)
+	}
+	if gw.End != "2025-05-06" || gw.Days != 4 || len(gw.Holidays) != 4 {
+		t.Errorf("unexpected Golden Week: %v", gw)
+	}
+	if gw.Holidays[0].Name != "Constitution Memorial Day" {
+		t.Errorf("unexpected name: %q", gw.Holidays[0].Name)
+	}
+
+	for _, path := range []string{"/long-weekends", "/25/long-weekends", "/2025/05/long-weekends"} {
+		req := httptest.NewRequest(http.MethodGet, "http://example.com"+path, nil)
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+		if w.Code != http.StatusNotFound {
+			t.Errorf("%s: want %d, got %d", path, http.StatusNotFound, w.Code)
+		}
+	}
+}
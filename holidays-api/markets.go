@@ -0,0 +1,69 @@
+package holidaysapi
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/shogo82148/holidays-jp/holidays-api/holiday"
+)
+
+// MarketResponse is the response of the market calendar api.
+type MarketResponse struct {
+	Market string `json:"market"`
+	Year   int    `json:"year"`
+
+	// TradingDays is the number of trading days in the year.
+	TradingDays int `json:"trading_days"`
+
+	// ClosingDays are the national holidays and the closing days of the market.
+	// Saturdays and Sundays are not included unless they are holidays.
+	ClosingDays []Holiday `json:"closing_days"`
+
+	// HalfDays are the half-day sessions (半日立会).
+	HalfDays []string `json:"half_days"`
+}
+
+var markets = map[string]*holiday.Market{
+	"jpx": holiday.JPX(),
+}
+
+func (h *Handler) market(w http.ResponseWriter, path string, lang holiday.Language) error {
+	// jpx/2006
+	name, rest, ok := strings.Cut(path, "/")
+	if !ok {
+		return errors.New("invalid path")
+	}
+	m, ok := markets[name]
+	if !ok {
+		return errors.New("unknown market")
+	}
+	year, month, _, err := parsePath(rest)
+	if err != nil {
+		return err
+	}
+	if year == 0 || month != 0 {
+		return errors.New("invalid year")
+	}
+	h.setCacheControlForYear(w, year)
+
+	closingDays := m.ClosingDaysInYear(year)
+	res := MarketResponse{
+		Market:      name,
+		Year:        year,
+		TradingDays: m.TradingDaysInYear(year),
+		ClosingDays: make([]Holiday, 0, len(closingDays)),
+		HalfDays:    []string{},
+	}
+	for _, d := range closingDays {
+		res.ClosingDays = append(res.ClosingDays, newHoliday(d, lang))
+	}
+	for _, d := range m.HalfDaysInYear(year) {
+		res.HalfDays = append(res.HalfDays, d.String())
+	}
+
+	w.Header().Set("Content-Language", string(lang))
+	w.Header().Set("Vary", "Accept-Language")
+	h.responseJSON(w, res)
+	return nil
+}
@@ -0,0 +1,73 @@
+package holidaysapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestServeHTTP_Market(t *testing.T) {
+	h := NewHandler()
+
+	t.Run("jpx", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "http://example.com/markets/jpx/2025", nil)
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+
+		resp := w.Result()
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("unexpected status code: want %d, got %d", http.StatusOK, resp.StatusCode)
+		}
+		if resp.Header.Get("Cache-Control") == "" {
+			t.Error("Cache-Control is not set")
+		}
+
+		var res MarketResponse
+		if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+			t.Fatal(err)
+		}
+		if res.Market != "jpx" || res.Year != 2025 || res.TradingDays != 243 {
+			t.Errorf("unexpected response: %v", res)
+		}
+		if len(res.ClosingDays) < 3 {
+			t.Fatalf("want closing days, got %v", res.ClosingDays)
+		}
+		want := []Holiday{
+			{Date: "2025-01-01", Name: "元日", NameKana: "がんじつ", Kind: "national", ID: "new-years-day", Era: "令和", EraYear: 7},
+			{Date: "2025-01-02", Name: "年始休業日", Kind: "custom", Era: "令和", EraYear: 7},
+			{Date: "2025-01-03", Name: "年始休業日", Kind: "custom", Era: "令和", EraYear: 7},
+		}
+		for i, w := range want {
+			if res.ClosingDays[i] != w {
+				t.Errorf("want %v, got %v", w, res.ClosingDays[i])
+			}
+		}
+		if len(res.HalfDays) != 0 {
+			t.Errorf("want no half-day sessions, got %v", res.HalfDays)
+		}
+	})
+
+	t.Run("half-day sessions", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "http://example.com/markets/jpx/2008", nil)
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+
+		var res MarketResponse
+		if err := json.NewDecoder(w.Result().Body).Decode(&res); err != nil {
+			t.Fatal(err)
+		}
+		if len(res.HalfDays) != 2 || res.HalfDays[0] != "2008-01-04" || res.HalfDays[1] != "2008-12-30" {
+			t.Errorf("unexpected half-day sessions: %v", res.HalfDays)
+		}
+	})
+
+	for _, path := range []string{"/markets/jpx", "/markets/nyse/2025", "/markets/jpx/2025/01", "/markets/jpx/25"} {
+		req := httptest.NewRequest(http.MethodGet, "http://example.com"+path, nil)
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+		if w.Code != http.StatusNotFound {
+			t.Errorf("%s: want %d, got %d", path, http.StatusNotFound, w.Code)
+		}
+	}
+}
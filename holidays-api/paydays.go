@@ -0,0 +1,60 @@
+package holidaysapi
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+
+	"github.com/shogo82148/holidays-jp/holidays-api/holiday"
+)
+
+// PaydaysResponse is the response of the paydays api.
+type PaydaysResponse struct {
+	// Rule is the normalized rule. e.g. "月末締め翌月末払い、休日の場合は前営業日"
+	Rule string `json:"rule"`
+
+	Paydays []Payday `json:"paydays"`
+}
+
+// Payday is a payday or a due date.
+type Payday struct {
+	Date string `json:"date"`
+
+	// Closing is the closing date (締め日) of the payment.
+	// It is omitted if the rule has no closing day.
+	Closing string `json:"closing,omitempty"`
+}
+
+func (h *Handler) paydays(w http.ResponseWriter, u *url.URL) error {
+	q := u.Query()
+	rule, err := holiday.ParsePaydayRule(q.Get("rule"))
+	if err != nil {
+		return err
+	}
+	year, _, err := parseYear(q.Get("year"))
+	if err != nil {
+		return err
+	}
+	if year == 0 {
+		return errors.New("invalid year")
+	}
+	h.setCacheControlForYear(w, year)
+
+	// the payments are made by bank transfers, so they follow the bank business days.
+	paydays := rule.InYear(holiday.Bank(), year)
+	res := PaydaysResponse{
+		Rule:    rule.String(),
+		Paydays: make([]Payday, 0, len(paydays)),
+	}
+	for _, p := range paydays {
+		payday := Payday{
+			Date: p.Date.String(),
+		}
+		if p.Closing != (holiday.Date{}) {
+			payday.Closing = p.Closing.String()
+		}
+		res.Paydays = append(res.Paydays, payday)
+	}
+	h.responseJSON(w, res)
+	return nil
+}
@@ -0,0 +1,71 @@
+package holidaysapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestServeHTTP_Paydays(t *testing.T) {
+	h := NewHandler()
+
+	t.Run("closing day", func(t *testing.T) {
+		q := url.Values{}
+		q.Set("rule", "月末締め翌月末払い、休日の場合は前営業日")
+		q.Set("year", "2025")
+		req := httptest.NewRequest(http.MethodGet, "http://example.com/paydays?"+q.Encode(), nil)
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+
+		resp := w.Result()
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("unexpected status code: want %d, got %d", http.StatusOK, resp.StatusCode)
+		}
+		if resp.Header.Get("Cache-Control") == "" {
+			t.Error("Cache-Control is not set")
+		}
+
+		var res PaydaysResponse
+		if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+			t.Fatal(err)
+		}
+		if res.Rule != "月末締め翌月末払い、休日の場合は前営業日" {
+			t.Errorf("unexpected rule: %q", res.Rule)
+		}
+		if len(res.Paydays) != 12 {
+			t.Fatalf("want 12 paydays, got %d", len(res.Paydays))
+		}
+		// 2025-05-31 is Saturday.
+		if got, want := res.Paydays[4], (Payday{Date: "2025-05-30", Closing: "2025-04-30"}); got != want {
+			t.Errorf("want %v, got %v", want, got)
+		}
+	})
+
+	t.Run("no closing day", func(t *testing.T) {
+		q := url.Values{}
+		q.Set("rule", "25日")
+		q.Set("year", "R7")
+		req := httptest.NewRequest(http.MethodGet, "http://example.com/paydays?"+q.Encode(), nil)
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+
+		var res PaydaysResponse
+		if err := json.NewDecoder(w.Result().Body).Decode(&res); err != nil {
+			t.Fatal(err)
+		}
+		if got, want := res.Paydays[4], (Payday{Date: "2025-05-25"}); got != want {
+			t.Errorf("want %v, got %v", want, got)
+		}
+	})
+
+	for _, query := range []string{"", "year=2025", "rule=25日", "rule=25日&year=25", "rule=32日&year=2025"} {
+		req := httptest.NewRequest(http.MethodGet, "http://example.com/paydays?"+query, nil)
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+		if w.Code != http.StatusNotFound {
+			t.Errorf("%q: want %d, got %d", query, http.StatusNotFound, w.Code)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package holidaysapi
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/shogo82148/holidays-jp/holidays-api/holiday"
+)
+
+// RokuyoResponse is the response of the rokuyo api.
+type RokuyoResponse struct {
+	Days []RokuyoDay `json:"days"`
+}
+
+// RokuyoDay is the rokuyo (六曜) of a day.
+type RokuyoDay struct {
+	Date string `json:"date"`
+
+	// Rokuyo is the name of the rokuyo. e.g. "大安"
+	Rokuyo string `json:"rokuyo"`
+
+	// Lunisolar is the date in the lunisolar calendar (旧暦).
+	Lunisolar LunisolarDate `json:"lunisolar"`
+}
+
+// LunisolarDate is a date in the lunisolar calendar (旧暦).
+type LunisolarDate struct {
+	Year  int  `json:"year"`
+	Month int  `json:"month"`
+	Leap  bool `json:"leap"`
+	Day   int  `json:"day"`
+}
+
+func (h *Handler) rokuyo(w http.ResponseWriter, path string, lang holiday.Language) error {
+	year, month, day, err := parsePath(path)
+	if err != nil {
+		return err
+	}
+	if year == 0 || month < 1 || month > 12 || day != 0 {
+		return errors.New("invalid month")
+	}
+	h.setCacheControlForYear(w, year)
+
+	first := holiday.Date{Year: year, Month: time.Month(month), Day: 1}
+	res := RokuyoResponse{
+		Days: make([]RokuyoDay, 0, 31),
+	}
+	for d := first; d.Month == first.Month; d = d.AddDays(1) {
+		ld := d.Lunisolar()
+		res.Days = append(res.Days, RokuyoDay{
+			Date:   d.String(),
+			Rokuyo: ld.Rokuyo().LocalizedName(lang),
+			Lunisolar: LunisolarDate{
+				Year:  ld.Year,
+				Month: ld.Month,
+				Leap:  ld.Leap,
+				Day:   ld.Day,
+			},
+		})
+	}
+
+	w.Header().Set("Content-Language", string(lang))
+	w.Header().Set("Vary", "Accept-Language")
+	h.responseJSON(w, res)
+	return nil
+}
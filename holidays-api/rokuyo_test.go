@@ -0,0 +1,67 @@
+package holidaysapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestServeHTTP_Rokuyo(t *testing.T) {
+	h := NewHandler()
+
+	t.Run("leap month", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "http://example.com/rokuyo/2025/07", nil)
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+
+		resp := w.Result()
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("unexpected status code: want %d, got %d", http.StatusOK, resp.StatusCode)
+		}
+		if resp.Header.Get("Cache-Control") == "" {
+			t.Error("Cache-Control is not set")
+		}
+
+		var res RokuyoResponse
+		if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+			t.Fatal(err)
+		}
+		if len(res.Days) != 31 {
+			t.Fatalf("want 31 days, got %d", len(res.Days))
+		}
+		got := res.Days[24]
+		want := RokuyoDay{
+			Date:      "2025-07-25",
+			Rokuyo:    "赤口",
+			Lunisolar: LunisolarDate{Year: 2025, Month: 6, Leap: true, Day: 1},
+		}
+		if got != want {
+			t.Errorf("want %v, got %v", want, got)
+		}
+	})
+
+	t.Run("english", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "http://example.com/rokuyo/2025/01?lang=en", nil)
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+
+		var res RokuyoResponse
+		if err := json.NewDecoder(w.Result().Body).Decode(&res); err != nil {
+			t.Fatal(err)
+		}
+		// 2025-01-29 is the lunar new year's day.
+		if got := res.Days[28].Rokuyo; got != "Sensho" {
+			t.Errorf("want Sensho, got %q", got)
+		}
+	})
+
+	for _, path := range []string{"/rokuyo/2025", "/rokuyo/2025/13", "/rokuyo/2025/01/01", "/rokuyo/2025/1"} {
+		req := httptest.NewRequest(http.MethodGet, "http://example.com"+path, nil)
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+		if w.Code != http.StatusNotFound {
+			t.Errorf("%s: want %d, got %d", path, http.StatusNotFound, w.Code)
+		}
+	}
+}
@@ -2,4 +2,13 @@ module github.com/shogo82148/holidays-jp/updater
 
 go 1.27.0
 
-require golang.org/x/text v0.41.0
+require (
+	github.com/shogo82148/holidays-jp/holidays-api v0.0.0
+	golang.org/x/text v0.41.0
+)
+
+require gopkg.in/yaml.v3 v3.0.1 // indirect
+
+// the test of the updater compares its classification of holidays with the holiday package in this repository.
+// the updater itself doesn't import it, so that it can regenerate a broken table.
+replace github.com/shogo82148/holidays-jp/holidays-api => ../holidays-api
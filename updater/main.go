@@ -14,12 +14,14 @@ import (
 	"log"
 	"net/http"
 	"os"
+	"os/exec"
 	"os/signal"
 	"path/filepath"
 	"sort"
 	"strconv"
 	"strings"
 	"syscall"
+	"time"
 
 	"golang.org/x/text/encoding/japanese"
 	"golang.org/x/text/transform"
@@ -31,6 +33,9 @@ const syukujitsuURL = "https://www8.cao.go.jp/chosei/shukujitsu/syukujitsu.csv"
 
 const rawDataPath = "../syukujitsu.csv"
 
+// the copy of the raw data that is embedded by the holiday package with the holidays_embed_csv tag.
+var embeddedDataPath = filepath.Join("..", "holidays-api", "holiday", "syukujitsu.csv")
+
 func main() {
 	if err := _main(); err != nil {
 		log.Fatal(err)
@@ -48,6 +53,23 @@ func _main() error {
 	if err := formatHolidays(rawData); err != nil {
 		return err
 	}
+	if err := verify(ctx); err != nil {
+		return err
+	}
+	return nil
+}
+
+// verify compares the holidays calculated based on the law with the downloaded data.
+func verify(ctx context.Context) error {
+	// rawDataPath is relative to the updater, and holidays-api is its sibling,
+	// so the same path is available from holidays-api.
+	cmd := exec.CommandContext(ctx, "go", "run", "./cmd/verify", "-csv", rawDataPath)
+	cmd.Dir = filepath.Join("..", "holidays-api")
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("the calculation of holidays doesn't match the downloaded data: %w", err)
+	}
 	return nil
 }
 
@@ -80,39 +102,26 @@ func download(ctx context.Context) ([]byte, error) {
 	if err := os.WriteFile(rawDataPath, buf, 0644); err != nil {
 		return nil, err
 	}
+	if err := os.WriteFile(embeddedDataPath, buf, 0644); err != nil {
+		return nil, err
+	}
 
 	return buf, nil
 }
 
-func formatHolidays(rawData []byte) error {
-	type Holiday struct {
-		Date string
-		Name string
-	}
-
-	reader := transform.NewReader(bytes.NewReader(rawData), japanese.ShiftJIS.NewDecoder())
-	csvReader := csv.NewReader(reader)
-
-	// skip 国民の祝日・休日月日,国民の祝日・休日名称 line
-	csvReader.Read()
+type Holiday struct {
+	Date string
+	Name string
+	Kind string
+	ID   string
+	Kana string
+}
 
-	holidays := []Holiday{}
-	for {
-		record, err := csvReader.Read()
-		if errors.Is(err, io.EOF) {
-			break
-		}
-		if err != nil {
-			return err
-		}
-		holidays = append(holidays, Holiday{
-			Date: formatDate(record[0]),
-			Name: record[1],
-		})
+func formatHolidays(rawData []byte) error {
+	holidays, err := parseHolidays(rawData)
+	if err != nil {
+		return err
 	}
-	sort.Slice(holidays, func(i, j int) bool {
-		return holidays[i].Date < holidays[j].Date
-	})
 
 	var buf bytes.Buffer
 	fmt.Fprint(
@@ -134,7 +143,20 @@ func formatHolidays(rawData []byte) error {
 		`,
 	)
 	for _, holiday := range holidays {
-		fmt.Fprintf(&buf, "{\nDate: %q,\nName: %q,\n},\n", holiday.Date, holiday.Name)
+		// a new holiday must not block the update of the table.
+		// it is generated with the empty ID and Kana, and they should be added to the maps below
+		// and to the ones in the holiday package.
+		if holiday.ID == "" {
+			log.Printf("WARNING: unknown holiday: %s %s", holiday.Date, holiday.Name)
+		}
+		if holiday.Kana == "" {
+			log.Printf("WARNING: unknown reading: %s %s", holiday.Date, holiday.Name)
+		}
+		if holiday.Kind == "" {
+			fmt.Fprintf(&buf, "{\nDate: %q,\nName: %q,\nID: %q,\nKana: %q,\n},\n", holiday.Date, holiday.Name, holiday.ID, holiday.Kana)
+		} else {
+			fmt.Fprintf(&buf, "{\nDate: %q,\nName: %q,\nKind: %s,\nID: %q,\nKana: %q,\n},\n", holiday.Date, holiday.Name, holiday.Kind, holiday.ID, holiday.Kana)
+		}
 	}
 	fmt.Fprintln(&buf, "}")
 
@@ -145,6 +167,134 @@ func formatHolidays(rawData []byte) error {
 	return os.WriteFile(filepath.Join("../", "holidays-api", "holiday", "holidays_generated.go"), res, 0644)
 }
 
+// parseHolidays parses syukujitsu.csv, and returns the holidays sorted by date.
+func parseHolidays(rawData []byte) ([]Holiday, error) {
+	reader := transform.NewReader(bytes.NewReader(rawData), japanese.ShiftJIS.NewDecoder())
+	csvReader := csv.NewReader(reader)
+
+	// skip 国民の祝日・休日月日,国民の祝日・休日名称 line
+	csvReader.Read()
+
+	holidays := []Holiday{}
+	for {
+		record, err := csvReader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		holidays = append(holidays, Holiday{
+			Date: formatDate(record[0]),
+			Name: record[1],
+		})
+	}
+	sort.Slice(holidays, func(i, j int) bool {
+		return holidays[i].Date < holidays[j].Date
+	})
+	classifyHolidays(holidays)
+	return holidays, nil
+}
+
+// classifyHolidays fills the Kind field with the name of the constant defined in the holiday package,
+// the ID field with the stable identifier of the holiday, and the Kana field with the reading.
+// The Kind of national holidays is left empty, because KindNational is the zero value.
+func classifyHolidays(holidays []Holiday) {
+	isHoliday := make(map[string]bool, len(holidays))
+	for _, holiday := range holidays {
+		isHoliday[holiday.Date] = true
+	}
+
+	for i, holiday := range holidays {
+		holidays[i].ID = holidayIDs[holiday.Name]
+		holidays[i].Kana = holidayKanas[holiday.Name]
+		switch holiday.Name {
+		case "結婚の儀", "大喪の礼", "即位礼正殿の儀":
+			holidays[i].Kind = "KindImperialCeremony"
+		case "休日（祝日扱い）":
+			holidays[i].Kind = "KindSpecial"
+			// the Cabinet Office uses the same name for these days.
+			switch holiday.Date {
+			case "2019-05-01":
+				holidays[i].ID = "enthronement-day"
+			case "2019-10-22":
+				holidays[i].ID = "enthronement-ceremony"
+			}
+		case "休日":
+			// a substitute holiday follows consecutive holidays that include a Sunday.
+			// otherwise, it is a citizens' holiday sandwiched between two holidays.
+			holidays[i].Kind = "KindCitizens"
+			holidays[i].ID = "citizens-holiday"
+			d, err := time.Parse("2006-01-02", holiday.Date)
+			if err != nil {
+				panic(err)
+			}
+			for d = d.AddDate(0, 0, -1); isHoliday[d.Format("2006-01-02")]; d = d.AddDate(0, 0, -1) {
+				if d.Weekday() == time.Sunday {
+					holidays[i].Kind = "KindSubstitute"
+					holidays[i].ID = "substitute-holiday"
+					break
+				}
+			}
+		}
+	}
+}
+
+// holidayIDs is a map from the official name in Japanese to the identifier.
+// It must be the same as the one in the holiday package, which is checked by TestClassifyHolidays.
+// The updater doesn't import the holiday package, so that it can regenerate a broken table.
+var holidayIDs = map[string]string{
+	"元日":           "new-years-day",
+	"成人の日":         "coming-of-age-day",
+	"建国記念の日":       "national-foundation-day",
+	"天皇誕生日":        "emperors-birthday",
+	"春分の日":         "vernal-equinox-day",
+	"昭和の日":         "showa-day",
+	"憲法記念日":        "constitution-memorial-day",
+	"みどりの日":        "greenery-day",
+	"こどもの日":        "childrens-day",
+	"海の日":          "marine-day",
+	"山の日":          "mountain-day",
+	"敬老の日":         "respect-for-the-aged-day",
+	"秋分の日":         "autumnal-equinox-day",
+	"体育の日":         "sports-day",
+	"体育の日（スポーツの日）": "sports-day",
+	"スポーツの日":       "sports-day",
+	"文化の日":         "culture-day",
+	"勤労感謝の日":       "labor-thanksgiving-day",
+	"結婚の儀":         "imperial-wedding-ceremony",
+	"大喪の礼":         "imperial-funeral-ceremony",
+	"即位礼正殿の儀":      "enthronement-ceremony",
+}
+
+// holidayKanas is a map from the official name in Japanese to the reading in hiragana.
+// It must be the same as the one in the holiday package, which is checked by TestClassifyHolidays.
+var holidayKanas = map[string]string{
+	"元日":           "がんじつ",
+	"成人の日":         "せいじんのひ",
+	"建国記念の日":       "けんこくきねんのひ",
+	"天皇誕生日":        "てんのうたんじょうび",
+	"春分の日":         "しゅんぶんのひ",
+	"昭和の日":         "しょうわのひ",
+	"憲法記念日":        "けんぽうきねんび",
+	"みどりの日":        "みどりのひ",
+	"こどもの日":        "こどものひ",
+	"海の日":          "うみのひ",
+	"山の日":          "やまのひ",
+	"敬老の日":         "けいろうのひ",
+	"秋分の日":         "しゅうぶんのひ",
+	"体育の日":         "たいいくのひ",
+	"体育の日（スポーツの日）": "たいいくのひ（すぽーつのひ）",
+	"スポーツの日":       "すぽーつのひ",
+	"文化の日":         "ぶんかのひ",
+	"勤労感謝の日":       "きんろうかんしゃのひ",
+	"結婚の儀":         "けっこんのぎ",
+	"大喪の礼":         "たいそうのれい",
+	"即位礼正殿の儀":      "そくいれいせいでんのぎ",
+	"休日":           "きゅうじつ",
+	"休日（祝日扱い）":     "きゅうじつ（しゅくじつあつかい）",
+}
+
 // 2021/1/1 -> 2021-01-01
 func formatDate(s string) string {
 	date := strings.Split(s, "/")
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"testing"
+
+	"github.com/shogo82148/holidays-jp/holidays-api/holiday"
+)
+
+// TestClassifyHolidays checks that the updater classifies the holidays in the same way as the holiday package.
+// The updater has its own copy of the classification, so that it doesn't depend on the code it generates.
+func TestClassifyHolidays(t *testing.T) {
+	rawData, err := os.ReadFile(rawDataPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := parseHolidays(rawData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ds, err := holiday.ParseCSV(bytes.NewReader(rawData))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := ds.Holidays()
+
+	kinds := map[string]holiday.Kind{
+		"":                     holiday.KindNational,
+		"KindSubstitute":       holiday.KindSubstitute,
+		"KindCitizens":         holiday.KindCitizens,
+		"KindSpecial":          holiday.KindSpecial,
+		"KindImperialCeremony": holiday.KindImperialCeremony,
+	}
+
+	if len(got) != len(want) {
+		t.Fatalf("unexpected number of holidays: want %d, got %d", len(want), len(got))
+	}
+	for i, h := range got {
+		w := want[i]
+		if h.Date != w.Date || h.Name != w.Name || kinds[h.Kind] != w.Kind || h.ID != w.ID || h.Kana != w.Kana {
+			t.Errorf("%s %s: want %s %s %s, got %s %s %s", h.Date, h.Name, w.Kind, w.ID, w.Kana, h.Kind, h.ID, h.Kana)
+		}
+	}
+}
+
+func TestFormatDate(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"1955/1/1", "1955-01-01"},
+		{"2021/12/31", "2021-12-31"},
+	}
+	for _, tt := range tests {
+		if got := formatDate(tt.in); got != tt.want {
+			t.Errorf("formatDate(%q): want %q, got %q", tt.in, tt.want, got)
+		}
+	}
+}